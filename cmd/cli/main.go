@@ -1,7 +1,89 @@
 package main
 
-import "github.com/tanmancan/gwordle/v1/internal/cli"
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/tanmancan/gwordle/v1/internal/cli"
+	"github.com/tanmancan/gwordle/v1/internal/config"
+	"github.com/tanmancan/gwordle/v1/internal/localization"
+	"github.com/tanmancan/gwordle/v1/internal/wengine"
+)
 
 func main() {
-	cli.InitCliGame()
+	args := os.Args[1:]
+	if len(args) > 0 && args[0] == "config" {
+		configCommand(args[1:])
+		return
+	}
+	if len(args) > 0 && args[0] == "i18n" {
+		i18nCommand(args[1:])
+		return
+	}
+
+	if err := config.Load(args); err != nil {
+		log.Fatalln(err)
+	}
+	localization.SetLocale(config.GlobalConfig.Locale)
+	wengine.LoadLocale(config.GlobalConfig.Locale)
+	switch config.GlobalConfig.Protocol {
+	case "text":
+	case "jsonl":
+		if config.GlobalConfig.RemoteAddr != "" {
+			log.Fatalln("the jsonl protocol only plays local games, and cannot be used with -remote")
+		}
+		cli.InitJsonlGame()
+		return
+	default:
+		log.Fatalf("unknown protocol %q, expected text or jsonl", config.GlobalConfig.Protocol)
+	}
+	if config.GlobalConfig.RemoteAddr != "" {
+		cli.InitRemoteCliGame(config.GlobalConfig.RemoteAddr)
+		return
+	}
+	switch config.GlobalConfig.UI {
+	case "cli":
+		cli.InitCliGame()
+	case "tui":
+		cli.InitTuiGame()
+	default:
+		log.Fatalf("unknown ui %q, expected cli or tui", config.GlobalConfig.UI)
+	}
+}
+
+// Runs the config subcommand.
+//
+//	config show [flags]  Print the effective configuration and where each value came from.
+func configCommand(args []string) {
+	if len(args) == 0 || args[0] != "show" {
+		fmt.Fprintln(os.Stderr, "Usage: gwordle config show [flags]")
+		os.Exit(2)
+	}
+	if err := config.Load(args[1:]); err != nil {
+		log.Fatalln(err)
+	}
+	config.WriteEffectiveConfig(os.Stdout)
+}
+
+// Runs the i18n subcommand.
+//
+//	i18n check  Check every locale for missing keys, unknown keys and format verbs that do not match English.
+//	            Exits with status 1 if any errors are found.
+func i18nCommand(args []string) {
+	if len(args) == 0 || args[0] != "check" {
+		fmt.Fprintln(os.Stderr, "Usage: gwordle i18n check")
+		os.Exit(2)
+	}
+	issues, err := localization.CheckLocales()
+	if err != nil {
+		log.Fatalln(err)
+	}
+	for _, issue := range issues {
+		fmt.Println(issue)
+	}
+	fmt.Printf("%d locales checked, %d issues found.\n", len(localization.Locales), len(issues))
+	if localization.HasErrors(issues) {
+		os.Exit(1)
+	}
 }
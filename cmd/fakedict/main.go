@@ -0,0 +1,29 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+
+	"github.com/tanmancan/gwordle/v1/internal/dictionaryapi"
+)
+
+// Serves a local dictionary file in the same JSON shape as api.dictionaryapi.dev.
+// Point the game at it with -dict-endpoint http://localhost:8081/api/v2/entries/en/%s
+func main() {
+	addr := flag.String("addr", ":8081", "Address the fake dictionary server listens on.")
+	file := flag.String("file", "", "Dictionary file to serve. Either a JSON list of definitions or one word per line.")
+	flag.Parse()
+
+	if *file == "" {
+		log.Fatalln("-file is required.")
+	}
+
+	provider, err := dictionaryapi.NewFileProvider(*file)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	log.Printf("Fake dictionary server with %d words listening on %s", len(provider.Definitions), *addr)
+	log.Fatalln(http.ListenAndServe(*addr, dictionaryapi.FakeServer{Provider: provider}))
+}
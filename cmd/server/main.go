@@ -0,0 +1,66 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"net"
+	"net/http"
+	"os"
+
+	"github.com/tanmancan/gwordle/v1/internal/config"
+	"github.com/tanmancan/gwordle/v1/internal/dictionaryapi"
+	"github.com/tanmancan/gwordle/v1/internal/localization"
+	"github.com/tanmancan/gwordle/v1/internal/rpc"
+	"github.com/tanmancan/gwordle/v1/internal/rpc/gwordlepb"
+	"github.com/tanmancan/gwordle/v1/internal/server"
+	"github.com/tanmancan/gwordle/v1/internal/wengine"
+	"google.golang.org/grpc"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "Address the REST API listens on. Empty to disable.")
+	grpcAddr := flag.String("grpc-addr", ":50051", "Address the gRPC service listens on. Empty to disable.")
+	if err := config.Load(os.Args[1:]); err != nil {
+		log.Fatalln(err)
+	}
+	localization.SetLocale(config.GlobalConfig.Locale)
+	wengine.LoadLocale(config.GlobalConfig.Locale)
+
+	if err := dictionaryapi.SetupProvider(); err != nil {
+		log.Fatalln(err)
+	}
+	if ttl := config.GlobalConfig.DictionaryCacheTTL; ttl > 0 {
+		if err := dictionaryapi.EnableCache(ttl); err != nil {
+			log.Println(err)
+		}
+	}
+
+	store := server.NewGameStore()
+	errs := make(chan error, 2)
+
+	if *grpcAddr != "" {
+		lis, err := net.Listen("tcp", *grpcAddr)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		grpcServer := grpc.NewServer()
+		gwordlepb.RegisterGwordleServer(grpcServer, rpc.NewGameServer(store))
+		log.Printf("gwordle gRPC service listening on %s", *grpcAddr)
+		go func() {
+			errs <- grpcServer.Serve(lis)
+		}()
+	}
+
+	if *addr != "" {
+		log.Printf("gwordle REST API listening on %s", *addr)
+		go func() {
+			errs <- http.ListenAndServe(*addr, &server.Server{Store: store})
+		}()
+	}
+
+	if *addr == "" && *grpcAddr == "" {
+		log.Fatalln("Both -addr and -grpc-addr are empty. Nothing to serve.")
+	}
+
+	log.Fatalln(<-errs)
+}
@@ -1,5 +1,17 @@
 module github.com/tanmancan/gwordle/v1
 
-go 1.17
+go 1.25.0
 
-require golang.org/x/text v0.3.7
+require (
+	github.com/BurntSushi/toml v1.6.0
+	golang.org/x/sys v0.43.0
+	golang.org/x/text v0.36.0
+	google.golang.org/grpc v1.82.1
+	google.golang.org/protobuf v1.36.11
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	golang.org/x/net v0.53.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20260414002931-afd174a4e478 // indirect
+)
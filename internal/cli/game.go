@@ -0,0 +1,185 @@
+package cli
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/tanmancan/gwordle/v1/internal/gengine"
+	"github.com/tanmancan/gwordle/v1/internal/localization"
+)
+
+// Returned by prompts once the user exits the game, or the input ends.
+var errExit = errors.New("exit")
+
+// Prompts the user for guesses and commands, and displays the messages of the game.
+// Implemented by the line based UI, and by the full-screen UI, which reads keys instead of lines.
+type UserPrompt interface {
+	// Get one or more guess words separated by spaces, or a command starting with a slash.
+	// Returns errExit once the user exits, or the input ends.
+	GetUserInput(gs *gengine.GameState) (string, error)
+	// Hides the game until the user returns. Returns errExit if the user exits instead.
+	HideGame(gs *gengine.GameState) error
+	// Displays help text.
+	DisplayHelpText(gs *gengine.GameState)
+	// Displays the best next guesses for the current round.
+	DisplayHint(gs *gengine.GameState)
+	// Displays the result of the current or last round, to be shared.
+	ShareResult(gs *gengine.GameState)
+	// Steps through a finished round, waiting for the user after each step.
+	ReplayRound(gs *gengine.GameState, args []string)
+	// Display a message when a user wins a round.
+	WinRoundMessage(gs *gengine.GameState)
+	// Display a message when a user loses a round.
+	LoseRoundMessage(gs *gengine.GameState)
+	// Display a message when a user exits the game.
+	ExitGameMessage(gs *gengine.GameState)
+}
+
+// Plays a local game in the terminal, one step of the engine at a time.
+type CliGame struct {
+	Prompt UserPrompt
+	Renderer gengine.Renderer
+	MemoryCard gengine.MemoryCard
+	game *gengine.Game
+}
+
+// Get a copy of the game state for the prompt and the renderer.
+func (cg *CliGame) view() *gengine.GameState {
+	gs := cg.game.State()
+	gs.Renderer = cg.Renderer
+	return &gs
+}
+
+// Save the game.
+func (cg *CliGame) save() {
+	gs := cg.game.State()
+	cg.MemoryCard.SaveGame(&gs.SaveState)
+}
+
+// Save the game and display the exit message.
+func (cg *CliGame) exit() {
+	cg.save()
+	cg.Prompt.ExitGameMessage(cg.view())
+}
+
+// Renders a round that just ended, with the win or lose message and the score.
+func (cg *CliGame) renderEndRound(result gengine.Result) {
+	gs := cg.view()
+	gs.SaveState.CurrentGame = result.Round
+	gs.RenderRound()
+	if result.Win {
+		cg.Prompt.WinRoundMessage(gs)
+	} else {
+		cg.Prompt.LoseRoundMessage(gs)
+	}
+	gs.Renderer.RenderGameScore(gs)
+}
+
+// Loads the saved game and runs the game loop until the user exits, the input ends or the daily puzzle was played.
+// The game is saved after every guess and on exit.
+func (cg *CliGame) Play() {
+	game, err := gengine.NewGame(newGameOptions(cg.MemoryCard.LoadGame()))
+	if err != nil {
+		cg.Renderer.RenderTextLn("%s", gengine.ErrorMessage(err))
+		return
+	}
+	cg.game = game
+
+	for {
+		cg.view().RenderRound()
+		words, err := cg.readGuess()
+		if err != nil {
+			cg.exit()
+			return
+		}
+
+		cg.game.GuessWords(words, func(result gengine.Result, err error) {
+			if err != nil {
+				cg.Renderer.RenderTextLn("%s", gengine.ErrorMessage(err))
+				return
+			}
+			if result.Win || result.Lose {
+				cg.renderEndRound(result)
+			}
+		})
+		cg.save()
+		if cg.game.Over() {
+			cg.exit()
+			return
+		}
+	}
+}
+
+// Prompt for input until it has guess words, running the commands in between.
+// Returns errExit once the user exits, the input ends or the daily puzzle was forfeited.
+func (cg *CliGame) readGuess() ([]string, error) {
+	for {
+		input, err := cg.Prompt.GetUserInput(cg.view())
+		if err != nil {
+			return nil, err
+		}
+		if input == "" {
+			continue
+		}
+		if input[0:1] != "/" {
+			return strings.Fields(input), nil
+		}
+		if err := cg.ParseUserCommand(input); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Runs a slash command. Words after the command are passed to it as arguments.
+// Returns errExit if the command ends the game.
+func (cg *CliGame) ParseUserCommand(ucmd string) error {
+	cmds := localization.AppTranslatable.Commands
+	fields := strings.Fields(ucmd)
+	if len(fields) == 0 {
+		return nil
+	}
+	gs := cg.view()
+	ucmd = fields[0]
+	switch strings.Trim(ucmd, "/") {
+	case cmds.Score:
+		gs.Renderer.RenderGameScore(gs)
+	case cmds.New:
+		result, err := cg.game.Forfeit()
+		if err != nil {
+			gs.Renderer.RenderTextLn("%s", gengine.ErrorMessage(err))
+			return nil
+		}
+		cg.renderEndRound(result)
+		cg.save()
+		if cg.game.Over() {
+			return errExit
+		}
+		cg.view().RenderRound()
+	case cmds.Help:
+		cg.Prompt.DisplayHelpText(gs)
+	case cmds.Exit:
+		return errExit
+	case cmds.Hide:
+		return cg.Prompt.HideGame(gs)
+	case cmds.Hint:
+		cg.game.LogHint()
+		cg.Prompt.DisplayHint(gs)
+	case cmds.Share:
+		cg.Prompt.ShareResult(gs)
+	case cmds.Replay:
+		cg.Prompt.ReplayRound(gs, fields[1:])
+	case cmds.Undo:
+		result, err := cg.game.Undo()
+		if err != nil {
+			gs.Renderer.RenderTextLn("%s", gengine.ErrorMessage(err))
+			return nil
+		}
+		cg.save()
+		gs.Renderer.RenderTextLn(localization.AppTranslatable.Undo.Done, strings.ToUpper(result.Word))
+		cg.view().RenderRound()
+	default:
+		gs.Renderer.RenderTextLn(cmds.InvalidCommand, ucmd)
+		cg.Prompt.DisplayHelpText(gs)
+	}
+	return nil
+}
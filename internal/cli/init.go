@@ -1,18 +1,23 @@
 package cli
 
 import (
+	"bufio"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"strings"
+	"unicode/utf8"
 
 	_ "embed"
 
 	"github.com/tanmancan/gwordle/v1/internal/config"
+	"github.com/tanmancan/gwordle/v1/internal/dictionaryapi"
 	"github.com/tanmancan/gwordle/v1/internal/gengine"
 	"github.com/tanmancan/gwordle/v1/internal/localization"
 	"github.com/tanmancan/gwordle/v1/internal/wengine"
+	"github.com/tanmancan/gwordle/v1/internal/wengine/solver"
 )
 
 type CliMemoryCard struct {}
@@ -23,6 +28,7 @@ type CliRenderer struct {}
 
 // Get the save filepath in the user's home directory.
 // Savefile are versioned. Old saves may not work with newer versions.
+// Each language of the word list has its own save, so a round is never resumed with the words of another language.
 func (mc CliMemoryCard) GetSaveFilePath() (string, error) {
 	hdir, err := os.UserHomeDir()
 	if err != nil {
@@ -33,7 +39,7 @@ func (mc CliMemoryCard) GetSaveFilePath() (string, error) {
 	if err := os.MkdirAll(sdir, os.ModePerm); err != nil {
 		return "", err
 	}
-	return fmt.Sprintf("%ssave-%s.json", sdir, config.GlobalConfig.Version), nil
+	return fmt.Sprintf("%ssave-%s-%s.json", sdir, config.GlobalConfig.Version, wengine.WordListCache.Locale), nil
 }
 
 // Load game from file.
@@ -76,75 +82,110 @@ func (mc CliMemoryCard) SaveGame(s *gengine.SaveState) {
 //go:embed static/hide
 var hideBlock string
 
-// Hides the current game prompt with some dummy logs.
-func (up CliUserPrompt) HideGame(gs *gengine.GameState) {
-	gs.Renderer.RenderTextLn(hideBlock)
-	gs.Renderer.RenderTextLn(localization.AppTranslatable.HideRound.Instructions)
-	up.HidePrompt(gs)
+// Reads input from stdin. Shared by every prompt, so input buffered by one prompt is not lost by the next.
+var stdinReader = bufio.NewReader(os.Stdin)
+
+// Read a line of input, without the line ending. Returns io.EOF once the input ends.
+func readLine() (string, error) {
+	line, err := stdinReader.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
 }
 
-// Checks user prompt to cancel or continue hide display.
-func (up CliUserPrompt) HidePrompt(gs *gengine.GameState) string {
+// Hides the current game prompt with some dummy logs, until the user types the return or exit word.
+// Returns errExit if the user exits, or the input ends.
+func (up CliUserPrompt) HideGame(gs *gengine.GameState) error {
 	hideRound := localization.AppTranslatable.HideRound
-	var input string
-	_, err := fmt.Scanln(&input)
-	if err != nil {
-		gs.Renderer.RenderTextLn(hideRound.InvalidInput)
-		return up.HidePrompt(gs)
-	}
+	gs.Renderer.RenderTextLn(hideBlock)
+	gs.Renderer.RenderTextLn(hideRound.Instructions)
+	for {
+		input, err := readLine()
+		if err != nil {
+			return errExit
+		}
 
-	switch strings.ToLower(input) {
-	case strings.ToLower(hideRound.Return):
-		return ""
-	case strings.ToLower(hideRound.Exit):
-		gs.Renderer.RenderTextLn(hideRound.Exit)
-		os.Exit(0)
-	default:
-		gs.Renderer.RenderTextLn("%s %s", hideRound.InvalidInput, hideRound.Instructions)
-		up.HidePrompt(gs)
+		switch strings.ToLower(strings.TrimSpace(input)) {
+		case strings.ToLower(hideRound.Return):
+			return nil
+		case strings.ToLower(hideRound.Exit):
+			gs.Renderer.RenderTextLn(hideRound.Exit)
+			return errExit
+		default:
+			gs.Renderer.RenderTextLn("%s %s", hideRound.InvalidInput, hideRound.Instructions)
+		}
 	}
-
-	return input
 }
 
-	// Get user input for a guess word or a help command.
-func (up CliUserPrompt) GetUserInput(gs *gengine.GameState) string {
+// Get a line of input: one or more guess words separated by spaces, or a command starting with a slash.
+// Returns errExit once the input ends.
+func (up CliUserPrompt) GetUserInput(gs *gengine.GameState) (string, error) {
 	gs.Renderer.RenderTextLn(localization.AppTranslatable.UserPrompt.Instructions, localization.AppTranslatable.Commands.Help)
-	gs.Renderer.RenderText(localization.AppTranslatable.UserPrompt.RemainingAttempts, gs.SaveState.CurrentGame.RemainingAttempts);
-	var guess string
-	_, err := fmt.Scan(&guess)
-
+	remainingAttempts := gs.SaveState.CurrentGame.RemainingAttempts
+	gs.Renderer.RenderText(localization.AppTranslatable.UserPrompt.RemainingAttempts.Select(remainingAttempts), remainingAttempts)
+	line, err := readLine()
 	if err != nil {
-		log.Fatalln(err)
+		if err != io.EOF {
+			log.Println(err)
+		}
+		return "", errExit
 	}
+	return strings.ToLower(strings.TrimSpace(line)), nil
+}
 
-	guess = strings.ToLower(guess)
+// Number of guess words suggested by the hint command.
+const hintSuggestionCount = 5
 
-	if guess[0:1] == "/" {
-		up.ParseUserCommand(guess, gs)
-		return gs.UserPrompt.GetUserInput(gs)
+// Displays the best next guesses and the number of remaining candidates for the current round.
+// In a multi-board round, the hint is for the first board that is not solved yet.
+func (up CliUserPrompt) DisplayHint(gs *gengine.GameState) {
+	labelsHint := localization.AppTranslatable.Hint
+	round := gs.SaveState.CurrentGame
+	var results []wengine.ValidationResult
+	for _, board := range round.GetBoards() {
+		if !board.Win {
+			results = board.Results
+			break
+		}
 	}
-
-	return guess
+	suggestions, candidates := solver.Suggest(&wengine.WordListCache, round.GetWordLength(), results, hintSuggestionCount, wengine.ValidationOptions{
+		FoldAccents: gs.FoldAccents,
+	})
+	gs.Renderer.RenderTextLn("\n"+labelsHint.Remaining, len(candidates))
+	for _, suggestion := range suggestions {
+		gs.Renderer.RenderTextLn(labelsHint.Suggestion, strings.ToUpper(suggestion.Word), suggestion.Entropy)
+	}
+	gs.Renderer.RenderText("\n")
 }
 
-func (up CliUserPrompt) ParseUserCommand(ucmd string, gs *gengine.GameState) {
-	cmds := localization.AppTranslatable.Commands
-	switch strings.Trim(ucmd, "/") {
-	case cmds.Score:
-		gs.Renderer.RenderGameScore(gs)
-	case cmds.New:
-		gs.LoseRound()
-	case cmds.Help:
-		gs.UserPrompt.DisplayHelpText(gs)
-	case cmds.Exit:
-		gs.ExitGame()
-	case cmds.Hide:
-		up.HideGame(gs)
-	default:
-		gs.Renderer.RenderTextLn(cmds.InvalidCommand, ucmd)
-		gs.UserPrompt.DisplayHelpText(gs)
+// Renders the emoji grid for the current or last round. Also appends it to the share file, if configured.
+func (up CliUserPrompt) ShareResult(gs *gengine.GameState) {
+	labelsShare := localization.AppTranslatable.Share
+	round, ok := gs.GetShareRound()
+	if !ok {
+		gs.Renderer.RenderTextLn(labelsShare.NothingToShare)
+		return
 	}
+
+	text := gengine.ShareText(round)
+	gs.Renderer.RenderTextLn("\n%s\n", text)
+
+	path := config.GlobalConfig.UserConfig.ShareFile
+	if path == "" {
+		return
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	defer f.Close()
+	if _, err := f.WriteString(text + "\n\n"); err != nil {
+		log.Println(err)
+		return
+	}
+	gs.Renderer.RenderTextLn(labelsShare.Saved, path)
 }
 
 // Displays help text.
@@ -155,32 +196,47 @@ func (up CliUserPrompt) DisplayHelpText(gs *gengine.GameState) {
 	gs.Renderer.RenderTextLn("/%s		%s", cmds.Score, cmds.ScoreDesc)
 	gs.Renderer.RenderTextLn("/%s		%s", cmds.New, cmds.NewDesc)
 	gs.Renderer.RenderTextLn("/%s		%s", cmds.Hide, cmds.HideDesc)
+	gs.Renderer.RenderTextLn("/%s		%s", cmds.Hint, cmds.HintDesc)
+	gs.Renderer.RenderTextLn("/%s		%s", cmds.Share, cmds.ShareDesc)
+	gs.Renderer.RenderTextLn("/%s		%s", cmds.Replay, cmds.ReplayDesc)
+	gs.Renderer.RenderTextLn("/%s		%s", cmds.Undo, cmds.UndoDesc)
 	gs.Renderer.RenderTextLn("/%s		%s\n", cmds.Exit, cmds.ExitDesc)
 }
 
 // Display a message when user loses a round.
 func (up CliUserPrompt) LoseRoundMessage(gs *gengine.GameState) {
 	labelsEndRound := localization.AppTranslatable.EndRound
-	lMsg := fmt.Sprintf(labelsEndRound.LoseMessage, strings.ToUpper(gs.SaveState.CurrentGame.SecretWord))
+	lMsg := fmt.Sprintf(labelsEndRound.LoseMessage, strings.ToUpper(gs.SaveState.CurrentGame.GetSecretWord()))
 	msg := fmt.Sprintf("| %s |", lMsg)
-	dWidth := len(msg)
+	dWidth := utf8.RuneCountInString(msg)
 	hRuleSlice := make([]string, dWidth)
 	for i := range hRuleSlice {
 		hRuleSlice[i] = "-"
 	}
 	hRule := strings.Join(hRuleSlice, "")
 	gs.Renderer.RenderTextLn("\n%s\n%s\n%s", hRule, msg, hRule)
+	up.shareOption(gs)
+}
+
+// Lets the user know the finished round can be shared.
+// The daily puzzle ends the game, so its result is shared right away instead.
+func (up CliUserPrompt) shareOption(gs *gengine.GameState) {
+	if gs.SaveState.CurrentGame.GetTries() == 0 {
+		return
+	}
+	if gs.Daily {
+		up.ShareResult(gs)
+		return
+	}
+	gs.Renderer.RenderTextLn(localization.AppTranslatable.Share.Option, localization.AppTranslatable.Commands.Share)
 }
 
 // Display a message when a user wins a round.
 func (up CliUserPrompt) WinRoundMessage(gs *gengine.GameState) {
 	labelsEndRound := localization.AppTranslatable.EndRound
-	triesLabel := labelsEndRound.Tries
-	totalTries := config.GlobalConfig.UserConfig.MaxTries - gs.SaveState.CurrentGame.RemainingAttempts
-	if (totalTries == 1) {
-		triesLabel = labelsEndRound.Try
-	}
-	gs.Renderer.RenderTextLn(labelsEndRound.WinMessage, gs.SaveState.CurrentGame.SecretWord, totalTries, triesLabel)
+	totalTries := gs.SaveState.CurrentGame.GetTries()
+	gs.Renderer.RenderTextLn(labelsEndRound.WinMessage.Select(totalTries), gs.SaveState.CurrentGame.GetSecretWord(), totalTries)
+	up.shareOption(gs)
 }
 
 // Display a message when a user exists the game.
@@ -189,35 +245,73 @@ func (up CliUserPrompt) ExitGameMessage(gs *gengine.GameState) {
 }
 
 
-// Renders the result of the word validation for the current round.
+// Renders the result of the word validation for the current round, using ActiveTheme.
 func (r CliRenderer) RenderValidationResults(gs *gengine.GameState) {
-	colorReset := "\033[0m"
-	colorGreen := "\033[32m"
-	colorYellow := "\033[33m"
+	unused := ActiveTheme.Unused
 	fmt.Print("\n")
 	for _, result := range gs.SaveState.CurrentGame.Results {
 		for _, c := range result.Chars {
-			var color string
-			char := c.Char
-			status := c.Status
-
-			switch status {
-			case wengine.InvalidCharacter:
-				color = colorReset
-			case wengine.InvalidPosition:
-				color = colorYellow
-			case wengine.ValidPosition:
-				color = colorGreen
-			}
-
-			fmt.Print(string(color), strings.ToUpper(char), " ", string(colorReset))
+			fmt.Print(ActiveTheme.Style(c.Status).Letter(c.Char), " ")
 		}
 
 		fmt.Print("\n")
 	}
 	for i := 0; i < gs.SaveState.CurrentGame.RemainingAttempts; i++ {
-		for i := 0; i < config.GlobalConfig.UserConfig.WordLength; i++ {
-			fmt.Print("_ ")
+		for i := 0; i < gs.SaveState.CurrentGame.GetWordLength(); i++ {
+			fmt.Print(unused.Open, "_", unused.Close, " ")
+		}
+		fmt.Print("\n")
+	}
+	fmt.Print("\n")
+	r.RenderLetterStatuses(gs)
+}
+
+// Number of boards rendered side by side before wrapping to a new row of boards.
+const boardsPerRow = 4
+
+// Renders the boards of a multi-board round side by side, using ActiveTheme.
+// Solved boards are padded with blank rows, since guesses are no longer validated against them.
+func (r CliRenderer) RenderBoards(gs *gengine.GameState) {
+	unused := ActiveTheme.Unused
+	round := gs.SaveState.CurrentGame
+	boards := round.GetBoards()
+	wordLength := round.GetWordLength()
+	blank := strings.Repeat(" ", utf8.RuneCountInString(unused.Open+"_"+unused.Close+" "))
+	fmt.Print("\n")
+	for start := 0; start < len(boards); start += boardsPerRow {
+		end := start + boardsPerRow
+		if end > len(boards) {
+			end = len(boards)
+		}
+		for row := 0; row < round.GetMaxTries(); row++ {
+			for _, board := range boards[start:end] {
+				for i := 0; i < wordLength; i++ {
+					switch {
+					case row < len(board.Results):
+						c := board.Results[row].Chars[i]
+						fmt.Print(ActiveTheme.Style(c.Status).Letter(c.Char), " ")
+					case board.Win:
+						fmt.Print(blank)
+					default:
+						fmt.Print(unused.Open, "_", unused.Close, " ")
+					}
+				}
+				fmt.Print("  ")
+			}
+			fmt.Print("\n")
+		}
+		fmt.Print("\n")
+	}
+	r.RenderLetterStatuses(gs)
+}
+
+// Renders a keyboard below the board, with each letter styled by its best known status in the current round.
+func (r CliRenderer) RenderLetterStatuses(gs *gengine.GameState) {
+	statuses := gs.SaveState.CurrentGame.GetLetterStatuses()
+	for i, row := range keyboardLayout() {
+		fmt.Print(strings.Repeat(" ", i))
+		for _, key := range row {
+			fmt.Print(ActiveTheme.Style(statuses.Get(string(key))).Letter(string(key)), " ")
 		}
 		fmt.Print("\n")
 	}
@@ -225,13 +319,57 @@ func (r CliRenderer) RenderValidationResults(gs *gengine.GameState) {
 }
 // Renders the current game score.
 func (r CliRenderer) RenderGameScore(gs *gengine.GameState) {
-	win, loss := gs.GetTotalWinLossCount()
+	stats := gs.SaveState.GetStatistics()
 	scrCard := localization.AppTranslatable.ScoreCard
 	gs.Renderer.RenderText("\n")
-	gs.Renderer.RenderTextLn(scrCard.TotalWin, win)
-	gs.Renderer.RenderTextLn(scrCard.TotalLoss, loss)
+	gs.Renderer.RenderTextLn(scrCard.TotalWin, stats.Wins)
+	gs.Renderer.RenderTextLn(scrCard.TotalLoss, stats.Losses)
+	gs.Renderer.RenderTextLn(scrCard.WinPercentage, stats.WinPercentage)
+	gs.Renderer.RenderTextLn(scrCard.CurrentStreak, stats.CurrentStreak)
+	gs.Renderer.RenderTextLn(scrCard.MaxStreak, stats.MaxStreak)
+	r.RenderGuessDistribution(gs, stats)
+	if stats.BoardsSolved+stats.BoardsFailed > 0 {
+		gs.Renderer.RenderTextLn("\n"+scrCard.BoardsSolved, stats.BoardsSolved)
+		gs.Renderer.RenderTextLn(scrCard.BoardsFailed, stats.BoardsFailed)
+		r.renderHistogram(gs, scrCard.BoardGuessDistribution, stats.BoardGuessDistribution)
+	}
 	gs.Renderer.RenderText("\n")
 }
+
+// Maximum width of a bar in the guess distribution histogram.
+const histogramWidth = 20
+
+// Renders a histogram of wins by the number of tries it took.
+func (r CliRenderer) RenderGuessDistribution(gs *gengine.GameState, stats gengine.Statistics) {
+	r.renderHistogram(gs, localization.AppTranslatable.ScoreCard.GuessDistribution, stats.GuessDistribution)
+}
+
+// Renders a histogram of the counts keyed by the number of tries, with a row for each try up to the maximum number of tries.
+func (r CliRenderer) renderHistogram(gs *gengine.GameState, title string, distribution map[int]int) {
+	maxTries := gs.GetMaxTries()
+	maxCount := 0
+	for tries, count := range distribution {
+		if tries > maxTries {
+			maxTries = tries
+		}
+		if count > maxCount {
+			maxCount = count
+		}
+	}
+
+	gs.Renderer.RenderTextLn("\n%s", title)
+	for tries := 1; tries <= maxTries; tries++ {
+		count := distribution[tries]
+		width := 0
+		if maxCount > 0 {
+			width = count * histogramWidth / maxCount
+		}
+		if count > 0 && width == 0 {
+			width = 1
+		}
+		gs.Renderer.RenderTextLn("%2d | %s %d", tries, strings.Repeat("█", width), count)
+	}
+}
 // Renders text inline,with string formatting.
 func (r CliRenderer) RenderText(format string, replacements ...interface{}) {
 	fmt.Printf(format, replacements...)
@@ -242,10 +380,45 @@ func (r CliRenderer) RenderTextLn(format string, replacements ...interface{}) {
 	fmt.Printf(f, replacements...)
 }
 
+// Set up the dictionary used to check words that are not in the word list.
+func setupDictionary() {
+	if err := dictionaryapi.SetupProvider(); err != nil {
+		log.Fatalln(err)
+	}
+	if ttl := config.GlobalConfig.DictionaryCacheTTL; ttl > 0 {
+		if err := dictionaryapi.EnableCache(ttl); err != nil {
+			log.Println(err)
+		}
+	}
+}
+
+// Get the rules of a game from the configuration, resuming the saved game s unless it is nil.
+// The word length, number of tries and boards are left to the engine, which uses the configuration as well.
+func newGameOptions(s *gengine.SaveState) gengine.Options {
+	return gengine.Options{
+		HardMode: config.GlobalConfig.UserConfig.HardMode,
+		Daily: config.GlobalConfig.UserConfig.Daily,
+		FoldAccents: config.GlobalConfig.UserConfig.FoldAccents,
+		Absurdle: config.GlobalConfig.UserConfig.Absurdle,
+		SaveState: s,
+	}
+}
+
+// Starts a local game with line based prompts, once the dictionary is set up.
+func startCliGame() {
+	cg := &CliGame{
+		Prompt: CliUserPrompt{},
+		Renderer: CliRenderer{},
+		MemoryCard: CliMemoryCard{},
+	}
+	cg.Play()
+}
+
+// Starts a local game with line based prompts.
 func InitCliGame() {
-	up := CliUserPrompt{}
-	r := CliRenderer{}
-	mc := CliMemoryCard{}
-	game := gengine.GameState{}
-	game.InitGame(up, r, mc)
+	setupDictionary()
+	if err := SetupTheme(); err != nil {
+		log.Fatalln(err)
+	}
+	startCliGame()
 }
@@ -0,0 +1,365 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/tanmancan/gwordle/v1/internal/gengine"
+	"github.com/tanmancan/gwordle/v1/internal/localization"
+	"github.com/tanmancan/gwordle/v1/internal/wengine"
+)
+
+// Commands of the jsonl protocol.
+const (
+	JsonlGuessCmd = "guess" // Submit the guess word in Word.
+	JsonlNewCmd = "new" // Forfeit the current round and start a new one.
+	JsonlScoreCmd = "score" // Get the player statistics.
+	JsonlStateCmd = "state" // Get the current round.
+)
+
+// Events of the jsonl protocol.
+const (
+	JsonlResultEvent = "result" // A guess was validated. Sent for each unsolved board of a multi-board round, before win or lose.
+	JsonlWinEvent = "win" // The round was won. A new round is started.
+	JsonlLoseEvent = "lose" // The round was lost or forfeited. A new round is started.
+	JsonlScoreEvent = "score" // The player statistics. Sent after win and lose, and for the score command.
+	JsonlStateEvent = "state" // The current round. Sent on start, and for the new and state commands.
+	JsonlErrorEvent = "error" // A command or guess was rejected. Rejected guesses do not use an attempt.
+	JsonlExitEvent = "exit" // The game ended, after the daily puzzle.
+)
+
+// A command read from a line of input. Example: {"cmd": "guess", "word": "crane"}
+type JsonlCommand struct {
+	Cmd string `json:"cmd"`
+	Word string `json:"word,omitempty"`
+}
+
+// An event written as a line of output. Only the fields of the event type are set.
+type JsonlEvent struct {
+	Event string `json:"event"`
+	Word string `json:"word,omitempty"` // The guess word of a result, or the secret word of a win or lose.
+	Words []string `json:"words,omitempty"` // The secret words of a multi-board win or lose, by board.
+	Board int `json:"board,omitempty"` // The board of a result in a multi-board round, starting at 1.
+	Match *bool `json:"match,omitempty"` // Whether the guess of a result matches the secret word.
+	Chars []JsonlChar `json:"chars,omitempty"` // Letters of a result.
+	RemainingAttempts *int `json:"remainingAttempts,omitempty"` // Attempts left in the round after a result.
+	Tries int `json:"tries,omitempty"` // Number of tries it took to win.
+	Message string `json:"message,omitempty"` // Localized message of a win, lose or error.
+	Round *JsonlRound `json:"round,omitempty"` // The current round, for state.
+	Score *JsonlScore `json:"score,omitempty"` // The player statistics, for score.
+}
+
+// Validation result of a letter.
+type JsonlChar struct {
+	Char string `json:"char"`
+	Status string `json:"status"`
+}
+
+// Validation result of a guess.
+type JsonlResult struct {
+	Word string `json:"word"`
+	Match bool `json:"match"`
+	Chars []JsonlChar `json:"chars"`
+}
+
+// The current round. The secret word is never included.
+type JsonlRound struct {
+	WordLength int `json:"wordLength"`
+	MaxTries int `json:"maxTries"`
+	RemainingAttempts int `json:"remainingAttempts"`
+	HardMode bool `json:"hardMode"`
+	Absurdle bool `json:"absurdle"`
+	DailyDate string `json:"dailyDate,omitempty"`
+	Results []JsonlResult `json:"results"` // Results of a single-board round.
+	Boards []JsonlBoard `json:"boards,omitempty"` // Boards of a multi-board round.
+}
+
+// A board of a multi-board round. The secret word is never included.
+type JsonlBoard struct {
+	Win bool `json:"win"`
+	Results []JsonlResult `json:"results"`
+}
+
+// The player statistics.
+type JsonlScore struct {
+	Played int `json:"played"`
+	Wins int `json:"wins"`
+	Losses int `json:"losses"`
+	WinPercentage float64 `json:"winPercentage"`
+	CurrentStreak int `json:"currentStreak"`
+	MaxStreak int `json:"maxStreak"`
+	GuessDistribution map[int]int `json:"guessDistribution"`
+	BoardsSolved int `json:"boardsSolved,omitempty"` // Only sent once a multi-board round was played.
+	BoardsFailed int `json:"boardsFailed,omitempty"`
+	BoardGuessDistribution map[int]int `json:"boardGuessDistribution,omitempty"`
+}
+
+func newJsonlChars(result wengine.ValidationResult) []JsonlChar {
+	chars := []JsonlChar{}
+	for _, c := range result.Chars {
+		chars = append(chars, JsonlChar{
+			Char: c.Char,
+			Status: c.Status,
+		})
+	}
+	return chars
+}
+
+func newJsonlRound(gs *gengine.GameState) *JsonlRound {
+	round := gs.SaveState.CurrentGame
+	jr := &JsonlRound{
+		WordLength: round.GetWordLength(),
+		MaxTries: round.GetMaxTries(),
+		RemainingAttempts: round.RemainingAttempts,
+		HardMode: gs.HardMode,
+		Absurdle: round.Absurdle,
+		DailyDate: round.DailyDate,
+		Results: []JsonlResult{},
+	}
+	jr.Results = newJsonlResults(round.Results)
+	for _, board := range round.Boards {
+		jr.Boards = append(jr.Boards, JsonlBoard{
+			Win: board.Win,
+			Results: newJsonlResults(board.Results),
+		})
+	}
+	return jr
+}
+
+func newJsonlResults(results []wengine.ValidationResult) []JsonlResult {
+	jrs := []JsonlResult{}
+	for _, result := range results {
+		var word strings.Builder
+		for _, c := range result.Chars {
+			word.WriteString(c.Char)
+		}
+		jrs = append(jrs, JsonlResult{
+			Word: word.String(),
+			Match: result.Match,
+			Chars: newJsonlChars(result),
+		})
+	}
+	return jrs
+}
+
+// Get the secret words of a multi-board round. Nil for a single board.
+func secretWords(round gengine.GameRound) []string {
+	var words []string
+	for _, board := range round.Boards {
+		words = append(words, board.SecretWord)
+	}
+	return words
+}
+
+// Writes the game as jsonl events.
+type JsonlRenderer struct {
+	enc *json.Encoder
+}
+
+// Write an event as a line of output.
+func (r *JsonlRenderer) Emit(event JsonlEvent) {
+	if err := r.enc.Encode(event); err != nil {
+		log.Println(err)
+	}
+}
+
+// Sends an error event with the localized message of the error.
+func (r *JsonlRenderer) RenderError(err error) {
+	r.Emit(JsonlEvent{
+		Event: JsonlErrorEvent,
+		Message: gengine.ErrorMessage(err),
+	})
+}
+
+// Sends the player statistics as a score event.
+func (r *JsonlRenderer) RenderGameScore(gs *gengine.GameState) {
+	stats := gs.SaveState.GetStatistics()
+	r.Emit(JsonlEvent{
+		Event: JsonlScoreEvent,
+		Score: &JsonlScore{
+			Played: stats.Played,
+			Wins: stats.Wins,
+			Losses: stats.Losses,
+			WinPercentage: stats.WinPercentage,
+			CurrentStreak: stats.CurrentStreak,
+			MaxStreak: stats.MaxStreak,
+			GuessDistribution: stats.GuessDistribution,
+			BoardsSolved: stats.BoardsSolved,
+			BoardsFailed: stats.BoardsFailed,
+			BoardGuessDistribution: stats.BoardGuessDistribution,
+		},
+	})
+}
+
+// Sends a win or lose event for the round that just ended, followed by a score event.
+// Events carry the secret word, or the secret words of a multi-board round.
+func (r *JsonlRenderer) RenderEndRound(gs *gengine.GameState, result gengine.Result) {
+	labelsEndRound := localization.AppTranslatable.EndRound
+	round := result.Round
+	event := JsonlEvent{
+		Event: JsonlLoseEvent,
+		Word: round.SecretWord,
+		Words: secretWords(round),
+		Message: fmt.Sprintf(labelsEndRound.LoseMessage, strings.ToUpper(round.GetSecretWord())),
+	}
+	if result.Win {
+		totalTries := round.GetTries()
+		event.Event = JsonlWinEvent
+		event.Tries = totalTries
+		event.Message = strings.TrimSpace(fmt.Sprintf(labelsEndRound.WinMessage.Select(totalTries), round.GetSecretWord(), totalTries))
+	}
+	r.Emit(event)
+	r.RenderGameScore(gs)
+}
+
+// Plays a local game driven by jsonl commands instead of prompts.
+type JsonlGame struct {
+	game *gengine.Game
+	renderer *JsonlRenderer
+}
+
+// Create a game that writes its events to w, resuming the saved game.
+// Returns the errors of gengine.NewGame if the game cannot be played.
+func NewJsonlGame(w io.Writer) (*JsonlGame, error) {
+	r := &JsonlRenderer{
+		enc: json.NewEncoder(w),
+	}
+	game, err := gengine.NewGame(newGameOptions(CliMemoryCard{}.LoadGame()))
+	if err != nil {
+		r.RenderError(err)
+		return nil, err
+	}
+	return &JsonlGame{
+		game: game,
+		renderer: r,
+	}, nil
+}
+
+// Run a command and send its events.
+func (g *JsonlGame) Handle(cmd JsonlCommand) {
+	r := g.renderer
+	switch cmd.Cmd {
+	case JsonlGuessCmd:
+		g.guess(cmd.Word)
+	case JsonlNewCmd:
+		result, err := g.game.Forfeit()
+		if err != nil {
+			r.RenderError(err)
+			break
+		}
+		gs := g.game.State()
+		r.RenderEndRound(&gs, result)
+		r.Emit(JsonlEvent{
+			Event: JsonlStateEvent,
+			Round: newJsonlRound(&gs),
+		})
+	case JsonlScoreCmd:
+		gs := g.game.State()
+		r.RenderGameScore(&gs)
+	case JsonlStateCmd:
+		gs := g.game.State()
+		r.Emit(JsonlEvent{
+			Event: JsonlStateEvent,
+			Round: newJsonlRound(&gs),
+		})
+	default:
+		r.Emit(JsonlEvent{
+			Event: JsonlErrorEvent,
+			Message: fmt.Sprintf("unknown command %q, expected %s, %s, %s or %s", cmd.Cmd, JsonlGuessCmd, JsonlNewCmd, JsonlScoreCmd, JsonlStateCmd),
+		})
+	}
+	gs := g.game.State()
+	CliMemoryCard{}.SaveGame(&gs.SaveState)
+}
+
+// Validate a guess word. Sends a result event for each board the guess was validated against, followed by win or lose if the round ended.
+// Sends an error event if the guess was rejected, without using an attempt.
+func (g *JsonlGame) guess(word string) {
+	r := g.renderer
+	if strings.TrimSpace(word) == "" {
+		r.Emit(JsonlEvent{
+			Event: JsonlErrorEvent,
+			Message: "missing word",
+		})
+		return
+	}
+
+	result, err := g.game.Guess(word)
+	if err != nil {
+		r.RenderError(err)
+		return
+	}
+
+	round := result.Round
+	for i, res := range result.Results {
+		if len(res.Chars) == 0 {
+			continue
+		}
+		event := JsonlEvent{
+			Event: JsonlResultEvent,
+			Word: result.Word,
+			Match: &res.Match,
+			Chars: newJsonlChars(res),
+			RemainingAttempts: &round.RemainingAttempts,
+		}
+		if round.IsMultiBoard() {
+			event.Board = i + 1
+		}
+		r.Emit(event)
+	}
+
+	if result.Win || result.Lose {
+		gs := g.game.State()
+		r.RenderEndRound(&gs, result)
+	}
+}
+
+// Read commands, one JSON object per line, until the end of the input or of the daily puzzle. Blank lines are skipped.
+func (g *JsonlGame) Run(in io.Reader) error {
+	scanner := bufio.NewScanner(in)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var cmd JsonlCommand
+		if err := json.Unmarshal([]byte(line), &cmd); err != nil {
+			g.renderer.Emit(JsonlEvent{
+				Event: JsonlErrorEvent,
+				Message: fmt.Sprintf("invalid command: %v", err),
+			})
+			continue
+		}
+		g.Handle(cmd)
+		if g.game.Over() {
+			g.renderer.Emit(JsonlEvent{
+				Event: JsonlExitEvent,
+			})
+			return nil
+		}
+	}
+	return scanner.Err()
+}
+
+// Starts a local game that reads jsonl commands from stdin and writes jsonl events to stdout.
+// Exits with status 1 if the game cannot be played, after sending the reason as an error event.
+func InitJsonlGame() {
+	setupDictionary()
+	g, err := NewJsonlGame(os.Stdout)
+	if err != nil {
+		os.Exit(1)
+	}
+	gs := g.game.State()
+	g.renderer.Emit(JsonlEvent{
+		Event: JsonlStateEvent,
+		Round: newJsonlRound(&gs),
+	})
+	if err := g.Run(os.Stdin); err != nil {
+		log.Fatalln(err)
+	}
+}
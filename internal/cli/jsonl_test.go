@@ -0,0 +1,122 @@
+package cli
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tanmancan/gwordle/v1/internal/config"
+	"github.com/tanmancan/gwordle/v1/internal/dictionaryapi"
+	"github.com/tanmancan/gwordle/v1/internal/gengine"
+	"github.com/tanmancan/gwordle/v1/internal/wengine"
+)
+
+// Plays each case from a saved round with the secret word poise and 2 tries, and compares every event line.
+func TestJsonlGame_Run(t *testing.T) {
+	prevProvider := dictionaryapi.ActiveProvider
+	prevConfig := config.GlobalConfig.UserConfig
+	prevWords := wengine.WordListCache
+	dictionaryapi.ActiveProvider = dictionaryapi.NoneProvider{}
+	t.Cleanup(func() {
+		dictionaryapi.ActiveProvider = prevProvider
+		config.GlobalConfig.UserConfig = prevConfig
+		wengine.WordListCache = prevWords
+	})
+	today := gengine.DailyDate(time.Now())
+
+	tests := []struct {
+		name  string
+		daily bool
+		input []string
+		want  []string
+	}{
+		{
+			name:  "Guess, score and state",
+			input: []string{`{"cmd":"guess","word":"zzzzz"}`, `{"cmd":"guess"}`, `{"cmd":"guess","word":"stare"}`, `{"cmd":"score"}`, `{"cmd":"state"}`},
+			want: []string{
+				`{"event":"error","message":"Invalid word: zzzzz"}`,
+				`{"event":"error","message":"missing word"}`,
+				`{"event":"result","word":"stare","match":false,"chars":[{"char":"s","status":"INVALID_POS"},{"char":"t","status":"INVALID_CHAR"},{"char":"a","status":"INVALID_CHAR"},{"char":"r","status":"INVALID_CHAR"},{"char":"e","status":"VALID_POS"}],"remainingAttempts":1}`,
+				`{"event":"score","score":{"played":0,"wins":0,"losses":0,"winPercentage":0,"currentStreak":0,"maxStreak":0,"guessDistribution":{}}}`,
+				`{"event":"state","round":{"wordLength":5,"maxTries":2,"remainingAttempts":1,"hardMode":false,"absurdle":false,"results":[{"word":"stare","match":false,"chars":[{"char":"s","status":"INVALID_POS"},{"char":"t","status":"INVALID_CHAR"},{"char":"a","status":"INVALID_CHAR"},{"char":"r","status":"INVALID_CHAR"},{"char":"e","status":"VALID_POS"}]}]}}`,
+			},
+		},
+		{
+			name:  "Invalid commands",
+			input: []string{`{"cmd":"undo"}`, `{`, ``},
+			want: []string{
+				`{"event":"error","message":"unknown command \"undo\", expected guess, new, score or state"}`,
+				`{"event":"error","message":"invalid command: unexpected end of JSON input"}`,
+			},
+		},
+		{
+			name:  "Win",
+			input: []string{`{"cmd":"guess","word":"POISE"}`},
+			want: []string{
+				`{"event":"result","word":"poise","match":true,"chars":[{"char":"p","status":"VALID_POS"},{"char":"o","status":"VALID_POS"},{"char":"i","status":"VALID_POS"},{"char":"s","status":"VALID_POS"},{"char":"e","status":"VALID_POS"}],"remainingAttempts":1}`,
+				`{"event":"win","word":"poise","tries":1,"message":"You have guessed the correct word (poise) in 1 try!"}`,
+				`{"event":"score","score":{"played":1,"wins":1,"losses":0,"winPercentage":100,"currentStreak":1,"maxStreak":1,"guessDistribution":{"1":1}}}`,
+			},
+		},
+		{
+			name:  "Lose",
+			input: []string{`{"cmd":"guess","word":"stare"}`, `{"cmd":"guess","word":"hello"}`},
+			want: []string{
+				`{"event":"result","word":"stare","match":false,"chars":[{"char":"s","status":"INVALID_POS"},{"char":"t","status":"INVALID_CHAR"},{"char":"a","status":"INVALID_CHAR"},{"char":"r","status":"INVALID_CHAR"},{"char":"e","status":"VALID_POS"}],"remainingAttempts":1}`,
+				`{"event":"result","word":"hello","match":false,"chars":[{"char":"h","status":"INVALID_CHAR"},{"char":"e","status":"INVALID_POS"},{"char":"l","status":"INVALID_CHAR"},{"char":"l","status":"INVALID_CHAR"},{"char":"o","status":"INVALID_POS"}],"remainingAttempts":0}`,
+				`{"event":"lose","word":"poise","message":"You lose. The word is: POISE"}`,
+				`{"event":"score","score":{"played":1,"wins":0,"losses":1,"winPercentage":0,"currentStreak":0,"maxStreak":0,"guessDistribution":{}}}`,
+			},
+		},
+		{
+			name:  "New round",
+			input: []string{`{"cmd":"new"}`},
+			want: []string{
+				`{"event":"lose","word":"poise","message":"You lose. The word is: POISE"}`,
+				`{"event":"score","score":{"played":1,"wins":0,"losses":1,"winPercentage":0,"currentStreak":0,"maxStreak":0,"guessDistribution":{}}}`,
+				`{"event":"state","round":{"wordLength":5,"maxTries":6,"remainingAttempts":6,"hardMode":false,"absurdle":false,"results":[]}}`,
+			},
+		},
+		{
+			name:  "Exit after the daily puzzle",
+			daily: true,
+			input: []string{`{"cmd":"guess","word":"poise"}`, `{"cmd":"state"}`},
+			want: []string{
+				`{"event":"result","word":"poise","match":true,"chars":[{"char":"p","status":"VALID_POS"},{"char":"o","status":"VALID_POS"},{"char":"i","status":"VALID_POS"},{"char":"s","status":"VALID_POS"},{"char":"e","status":"VALID_POS"}],"remainingAttempts":1}`,
+				`{"event":"win","word":"poise","tries":1,"message":"You have guessed the correct word (poise) in 1 try!"}`,
+				`{"event":"score","score":{"played":1,"wins":1,"losses":0,"winPercentage":100,"currentStreak":1,"maxStreak":1,"guessDistribution":{"1":1}}}`,
+				`{"event":"exit"}`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("HOME", t.TempDir())
+			config.GlobalConfig.UserConfig.Daily = tt.daily
+			round := gengine.GameRound{
+				SecretWord:        "poise",
+				RemainingAttempts: 2,
+				WordLength:        5,
+				MaxTries:          2,
+			}
+			if tt.daily {
+				round.DailyDate = today
+			}
+			CliMemoryCard{}.SaveGame(&gengine.SaveState{CurrentGame: round})
+
+			var out strings.Builder
+			g, err := NewJsonlGame(&out)
+			if err != nil {
+				t.Fatalf("NewJsonlGame() error = %v", err)
+			}
+			if err := g.Run(strings.NewReader(strings.Join(tt.input, "\n"))); err != nil {
+				t.Fatalf("JsonlGame.Run() error = %v", err)
+			}
+			got := strings.Split(strings.TrimSpace(out.String()), "\n")
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("JsonlGame.Run() events:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package cli
+
+import (
+	"github.com/tanmancan/gwordle/v1/internal/wengine"
+	"golang.org/x/text/language"
+)
+
+// Rows of the keyboard shown below the board, keyed by language. Languages without a layout use English.
+var keyboardLayouts = map[language.Base][]string{
+	language.MustParseBase("en"): {"qwertyuiop", "asdfghjkl", "zxcvbnm"},
+	language.MustParseBase("es"): {"qwertyuiop", "asdfghjklñ", "zxcvbnm"},
+}
+
+// Get the rows of the keyboard for the language of the word list.
+func keyboardLayout() []string {
+	base, _ := wengine.WordListCache.Locale.Base()
+	if layout, ok := keyboardLayouts[base]; ok {
+		return layout
+	}
+	return keyboardLayouts[language.MustParseBase("en")]
+}
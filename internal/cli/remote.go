@@ -0,0 +1,173 @@
+package cli
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/tanmancan/gwordle/v1/internal/config"
+	"github.com/tanmancan/gwordle/v1/internal/gengine"
+	"github.com/tanmancan/gwordle/v1/internal/localization"
+	"github.com/tanmancan/gwordle/v1/internal/rpc"
+	"github.com/tanmancan/gwordle/v1/internal/rpc/gwordlepb"
+	"github.com/tanmancan/gwordle/v1/internal/server"
+	"google.golang.org/grpc/status"
+)
+
+// Plays against a remote gwordle gRPC server instead of the local engine.
+type RemoteCliGame struct {
+	Client *rpc.Client
+	Renderer CliRenderer
+	round *gwordlepb.GameRound
+}
+
+// Builds a view of the current round for the renderer.
+func (rg *RemoteCliGame) view() *gengine.GameState {
+	gs := &gengine.GameState{
+		Renderer: rg.Renderer,
+	}
+	gs.SaveState.CurrentGame.RemainingAttempts = int(rg.round.GetRemainingAttempts())
+	gs.SaveState.CurrentGame.WordLength = int(rg.round.GetWordLength())
+	gs.SaveState.CurrentGame.MaxTries = int(rg.round.GetMaxTries())
+	for _, result := range rg.round.GetResults() {
+		gs.SaveState.CurrentGame.Results = append(gs.SaveState.CurrentGame.Results, rpc.ResultFromProto(result))
+	}
+	return gs
+}
+
+// Renders the messages and the new round returned by the server.
+// The state of the response is already the next round, so a round that ended is rendered with the result of the last guess added to it.
+func (rg *RemoteCliGame) handleResponse(resp *gwordlepb.GuessResponse) {
+	if resp.GetWin() || resp.GetLose() {
+		gs := rg.view()
+		if resp.GetResult() != nil {
+			gs.SaveState.CurrentGame.Results = append(gs.SaveState.CurrentGame.Results, rpc.ResultFromProto(resp.GetResult()))
+			gs.SaveState.CurrentGame.RemainingAttempts--
+		}
+		rg.Renderer.RenderValidationResults(gs)
+	}
+	for _, msg := range resp.GetMessages() {
+		rg.Renderer.RenderTextLn("%s", msg)
+	}
+	rg.round = resp.GetState()
+}
+
+// Renders the error returned by the server.
+func (rg *RemoteCliGame) renderError(err error) {
+	if s, ok := status.FromError(err); ok {
+		rg.Renderer.RenderTextLn("%s", s.Message())
+		return
+	}
+	rg.Renderer.RenderTextLn("%v", err)
+}
+
+// Displays help text for the commands supported against a remote server.
+func (rg *RemoteCliGame) displayHelpText() {
+	cmds := localization.AppTranslatable.Commands
+	rg.Renderer.RenderTextLn("\n%s", cmds.HelpTextIntro)
+	rg.Renderer.RenderTextLn("/%s		%s", cmds.Help, cmds.HelpDesc)
+	rg.Renderer.RenderTextLn("/%s		%s", cmds.Score, cmds.ScoreDesc)
+	rg.Renderer.RenderTextLn("/%s		%s", cmds.New, cmds.NewDesc)
+	rg.Renderer.RenderTextLn("/%s		%s\n", cmds.Exit, cmds.ExitDesc)
+}
+
+// Submits each guess word in order, until the round is won or lost.
+// Rejected words are reported without using an attempt.
+func (rg *RemoteCliGame) guessWords(ctx context.Context, words []string) {
+	for _, word := range words {
+		resp, err := rg.Client.Guess(ctx, rg.round.GetGameId(), word)
+		if err != nil {
+			rg.renderError(err)
+			continue
+		}
+		rg.handleResponse(resp)
+		if resp.GetWin() || resp.GetLose() {
+			return
+		}
+	}
+}
+
+// Runs the remote game loop until the user exits or input ends.
+func (rg *RemoteCliGame) Play(ctx context.Context) error {
+	round, err := rg.Client.NewGame(ctx, server.GameRules{
+		HardMode: config.GlobalConfig.UserConfig.HardMode,
+		WordLength: config.GlobalConfig.UserConfig.WordLength,
+		MaxTries: config.GlobalConfig.UserConfig.MaxTries,
+	})
+	if err != nil {
+		return err
+	}
+	rg.round = round
+
+	cmds := localization.AppTranslatable.Commands
+	for {
+		rg.Renderer.RenderValidationResults(rg.view())
+		rg.Renderer.RenderTextLn(localization.AppTranslatable.UserPrompt.Instructions, cmds.Help)
+		remainingAttempts := int(rg.round.GetRemainingAttempts())
+		rg.Renderer.RenderText(localization.AppTranslatable.UserPrompt.RemainingAttempts.Select(remainingAttempts), remainingAttempts)
+
+		line, err := readLine()
+		if err != nil {
+			return nil
+		}
+		words := strings.Fields(strings.ToLower(line))
+		if len(words) == 0 {
+			continue
+		}
+		input := words[0]
+
+		if input[0:1] != "/" {
+			rg.guessWords(ctx, words)
+			continue
+		}
+
+		switch strings.Trim(input, "/") {
+		case cmds.Score:
+			stats, err := rg.Client.GetStats(ctx, rg.round.GetGameId())
+			if err != nil {
+				rg.renderError(err)
+				continue
+			}
+			scrCard := localization.AppTranslatable.ScoreCard
+			rg.Renderer.RenderText("\n")
+			rg.Renderer.RenderTextLn(scrCard.TotalWin, stats.GetWins())
+			rg.Renderer.RenderTextLn(scrCard.TotalLoss, stats.GetLosses())
+			rg.Renderer.RenderText("\n")
+		case cmds.New:
+			resp, err := rg.Client.Forfeit(ctx, rg.round.GetGameId())
+			if err != nil {
+				rg.renderError(err)
+				continue
+			}
+			rg.handleResponse(resp)
+		case cmds.Help:
+			rg.displayHelpText()
+		case cmds.Exit:
+			rg.Renderer.RenderTextLn("Good bye!")
+			return nil
+		default:
+			rg.Renderer.RenderTextLn(cmds.InvalidCommand, input)
+			rg.displayHelpText()
+		}
+	}
+}
+
+// Plays against the remote gwordle gRPC server at the given address.
+func InitRemoteCliGame(addr string) {
+	if err := SetupTheme(); err != nil {
+		log.Fatalln(err)
+	}
+	client, err := rpc.Dial(addr)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	defer client.Close()
+
+	game := RemoteCliGame{
+		Client: client,
+		Renderer: CliRenderer{},
+	}
+	if err := game.Play(context.Background()); err != nil {
+		log.Fatalln(err)
+	}
+}
@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/tanmancan/gwordle/v1/internal/gengine"
+	"github.com/tanmancan/gwordle/v1/internal/localization"
+)
+
+// Steps through a finished round, with the board as it was after each event.
+// Replays the last round, or the past round given as the first argument, starting at 1.
+func (up CliUserPrompt) ReplayRound(gs *gengine.GameState, args []string) {
+	replayRound(gs, args, up.nextReplayStep)
+}
+
+// Steps through the round picked by args, as described by UserPrompt.ReplayRound.
+// next is called after each step with the replayed state, and stops the replay when it returns false.
+func replayRound(gs *gengine.GameState, args []string, next func(replay *gengine.GameState, last bool) bool) {
+	labels := localization.AppTranslatable.Replay
+	pastGames := gs.SaveState.PastGames
+	if len(pastGames) == 0 {
+		gs.Renderer.RenderTextLn(labels.NothingToReplay)
+		return
+	}
+
+	number := len(pastGames)
+	if len(args) > 0 {
+		n, err := strconv.Atoi(args[0])
+		if err != nil || n < 1 || n > len(pastGames) {
+			gs.Renderer.RenderTextLn(labels.InvalidRound, args[0], len(pastGames))
+			return
+		}
+		number = n
+	}
+
+	round := pastGames[number-1]
+	steps := gengine.ReplayRound(round)
+	replay := &gengine.GameState{
+		Renderer: gs.Renderer,
+	}
+	gs.Renderer.RenderTextLn("\n"+labels.Intro, number, len(pastGames))
+	for i, step := range steps {
+		gs.Renderer.RenderTextLn(labels.Step, i+1, len(steps), describeReplayStep(round, step))
+		replay.SaveState.CurrentGame = step.Round
+		replay.RenderRound()
+		if i == len(steps)-1 {
+			gs.Renderer.RenderTextLn(labels.End)
+			next(replay, true)
+			return
+		}
+		gs.Renderer.RenderText(labels.Next + " ")
+		if !next(replay, false) {
+			return
+		}
+	}
+}
+
+// Describe the event of a replayed step.
+func describeReplayStep(round gengine.GameRound, step gengine.ReplayStep) string {
+	labels := localization.AppTranslatable.Replay
+	switch step.Event.Type {
+	case gengine.RoundStarted:
+		return labels.Started
+	case gengine.GuessSubmitted:
+		return fmt.Sprintf(labels.Guess, strings.ToUpper(step.Event.Word))
+	case gengine.HintUsed:
+		return labels.Hint
+	case gengine.GuessUndone:
+		return fmt.Sprintf(labels.Undo, strings.ToUpper(step.Event.Word))
+	case gengine.RoundForfeited:
+		return labels.Forfeit
+	case gengine.RoundWon:
+		return labels.Win
+	case gengine.RoundLost:
+		return fmt.Sprintf(labels.Lose, strings.ToUpper(round.GetSecretWord()))
+	}
+	return step.Event.Type
+}
+
+// Waits for the next step of a replay. Returns true if the user pressed Enter without typing anything.
+// The last step stays on screen, so there is nothing to wait for.
+func (up CliUserPrompt) nextReplayStep(replay *gengine.GameState, last bool) bool {
+	if last {
+		return true
+	}
+	line, err := readLine()
+	return err == nil && strings.TrimSpace(line) == ""
+}
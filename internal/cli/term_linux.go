@@ -0,0 +1,32 @@
+package cli
+
+import "golang.org/x/sys/unix"
+
+// Returns true if the file descriptor is a terminal.
+func isTerminal(fd int) bool {
+	_, err := unix.IoctlGetTermios(fd, unix.TCGETS)
+	return err == nil
+}
+
+// Put the terminal in raw mode, so keys are read one at a time without being echoed.
+// Output processing is left on, so a new line still moves the cursor to the start of the line.
+// Returns a function that restores the previous mode.
+func enableRawMode(fd int) (func() error, error) {
+	prev, err := unix.IoctlGetTermios(fd, unix.TCGETS)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := *prev
+	raw.Iflag &^= unix.ICRNL | unix.IXON | unix.ISTRIP | unix.INLCR | unix.IGNCR
+	raw.Lflag &^= unix.ECHO | unix.ECHONL | unix.ICANON | unix.ISIG | unix.IEXTEN
+	raw.Cc[unix.VMIN] = 1
+	raw.Cc[unix.VTIME] = 0
+	if err := unix.IoctlSetTermios(fd, unix.TCSETS, &raw); err != nil {
+		return nil, err
+	}
+
+	return func() error {
+		return unix.IoctlSetTermios(fd, unix.TCSETS, prev)
+	}, nil
+}
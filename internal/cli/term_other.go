@@ -0,0 +1,18 @@
+//go:build !linux
+
+package cli
+
+import "errors"
+
+// Returned when the terminal cannot be put in raw mode on this platform.
+var errRawModeUnsupported = errors.New("the full-screen UI is not supported on this platform")
+
+// Returns true if the file descriptor is a terminal. Always false, since raw mode is not supported.
+func isTerminal(fd int) bool {
+	return false
+}
+
+// Put the terminal in raw mode. Not supported on this platform.
+func enableRawMode(fd int) (func() error, error) {
+	return nil, errRawModeUnsupported
+}
@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/tanmancan/gwordle/v1/internal/config"
+	"github.com/tanmancan/gwordle/v1/internal/wengine"
+)
+
+// How a letter with a given status is drawn.
+type LetterStyle struct {
+	Text string // Color of the letter in the line based UI, as an ANSI escape code. Example: \033[32m for green.
+	Tile string // Color of the tile in the full-screen UI, as an ANSI escape code. Example: \033[1;30;42m for black on green.
+	Open string // Marker before the letter, for themes without color. Example: [
+	Close string // Marker after the letter, for themes without color. Example: ]
+}
+
+// Styles of the letters by status, used for the board and the keyboard.
+type Theme struct {
+	Correct LetterStyle // Letter in the correct position.
+	Present LetterStyle // Letter in the word, but in another position.
+	Absent LetterStyle // Letter not in the word.
+	Unused LetterStyle // Letter that was not guessed yet, and empty tiles.
+}
+
+// Resets the color after a letter.
+const colorReset = "\033[0m"
+
+// Theme used when color is disabled.
+const monochromeTheme = "monochrome"
+
+// Themes that can be selected with -theme.
+var Themes = map[string]Theme{
+	"default": {
+		Correct: LetterStyle{Text: "\033[32m", Tile: "\033[1;30;42m"},
+		Present: LetterStyle{Text: "\033[33m", Tile: "\033[1;30;43m"},
+		Absent: LetterStyle{Text: "\033[90m", Tile: "\033[1;37;100m"},
+		Unused: LetterStyle{Text: "\033[0m", Tile: "\033[1;30;47m"},
+	},
+	// Orange and blue, which can be told apart with the common forms of color blindness.
+	"colorblind": {
+		Correct: LetterStyle{Text: "\033[38;5;208m", Tile: "\033[1;30;48;5;208m"},
+		Present: LetterStyle{Text: "\033[38;5;39m", Tile: "\033[1;30;48;5;39m"},
+		Absent: LetterStyle{Text: "\033[90m", Tile: "\033[1;37;100m"},
+		Unused: LetterStyle{Text: "\033[0m", Tile: "\033[1;30;47m"},
+	},
+	// Bright, bold colors. Correct letters are also underlined in the line based UI.
+	"high-contrast": {
+		Correct: LetterStyle{Text: "\033[1;4;92m", Tile: "\033[1;30;102m"},
+		Present: LetterStyle{Text: "\033[1;93m", Tile: "\033[1;30;103m"},
+		Absent: LetterStyle{Text: "\033[2;37m", Tile: "\033[1;97;40m"},
+		Unused: LetterStyle{Text: "\033[1;97m", Tile: "\033[1;30;107m"},
+	},
+	// No color. Correct letters are shown as [A], present letters as (A) and absent letters as -A-.
+	monochromeTheme: {
+		Correct: LetterStyle{Open: "[", Close: "]"},
+		Present: LetterStyle{Open: "(", Close: ")"},
+		Absent: LetterStyle{Open: "-", Close: "-"},
+		Unused: LetterStyle{Open: " ", Close: " "},
+	},
+}
+
+// Theme used by the renderers. Set by SetupTheme.
+var ActiveTheme = Themes["default"]
+
+// Get the names of the themes, sorted.
+func ThemeNames() []string {
+	var names []string
+	for name := range Themes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Returns true if color should not be used: NO_COLOR is set, or stdout is not a terminal.
+// See https://no-color.org
+func colorDisabled() bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return true
+	}
+	info, err := os.Stdout.Stat()
+	return err != nil || info.Mode()&os.ModeCharDevice == 0
+}
+
+// Set the active theme from the configuration. The monochrome theme is used instead when color is disabled.
+func SetupTheme() error {
+	theme, ok := Themes[config.GlobalConfig.Theme]
+	if !ok {
+		return fmt.Errorf("unknown theme %q, expected one of: %s", config.GlobalConfig.Theme, strings.Join(ThemeNames(), ", "))
+	}
+	if colorDisabled() {
+		theme = Themes[monochromeTheme]
+	}
+	ActiveTheme = theme
+	return nil
+}
+
+// Get the style of a letter with the status.
+func (t Theme) Style(status wengine.CharValidationStatus) LetterStyle {
+	switch status {
+	case wengine.ValidPosition:
+		return t.Correct
+	case wengine.InvalidPosition:
+		return t.Present
+	case wengine.InvalidCharacter:
+		return t.Absent
+	}
+	return t.Unused
+}
+
+// Returns true if the theme draws with colors rather than markers.
+func (t Theme) Colored() bool {
+	return t.Unused.Text != ""
+}
+
+// Draw a letter for the line based UI. Example: [A] for a correct letter in the monochrome theme.
+func (s LetterStyle) Letter(char string) string {
+	letter := s.Open + strings.ToUpper(char) + s.Close
+	if s.Text == "" {
+		return letter
+	}
+	return s.Text + letter + colorReset
+}
+
+// Draw a letter as a tile for the full-screen UI. Tiles are three columns wide in every theme.
+func (s LetterStyle) TileLetter(char string) string {
+	if s.Tile == "" {
+		return s.Open + strings.ToUpper(char) + s.Close
+	}
+	return s.Tile + " " + strings.ToUpper(char) + " " + colorReset
+}
@@ -0,0 +1,397 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/tanmancan/gwordle/v1/internal/gengine"
+	"github.com/tanmancan/gwordle/v1/internal/localization"
+	"github.com/tanmancan/gwordle/v1/internal/wengine"
+)
+
+// Full-screen front end. Draws the board and an on-screen keyboard in place, and reads the guess key by key.
+type TuiUserPrompt struct {
+	CliUserPrompt
+	renderer *TuiRenderer
+	reader *bufio.Reader
+}
+
+// Draws the game in place using ANSI escape codes.
+// Text rendered by the game is kept as messages below the keyboard until the next guess is submitted.
+type TuiRenderer struct {
+	out io.Writer
+	restore func() error // Restores the terminal mode. Nil when the terminal was not put in raw mode.
+	closed bool // The terminal was restored. Text is written directly to out.
+	input []rune // Letters of the guess being typed, or the command being typed, starting with a slash.
+	messages []string // Lines of text rendered since the last guess. The last line may be incomplete.
+	reveal bool // A guess was submitted, and its result is revealed one letter at a time.
+	resultCount int // Number of tries when the guess was submitted.
+}
+
+const (
+	tuiEnterScreen = "\033[?1049h\033[?25l" // Switch to the alternate screen and hide the cursor.
+	tuiLeaveScreen = "\033[?25h\033[?1049l" // Show the cursor and switch back to the main screen.
+	tuiClearScreen = "\033[H\033[2J" // Move the cursor to the top left and clear the screen.
+	tuiShowCursor = "\033[?25h"
+	tuiHideCursor = "\033[?25l"
+	tuiEmpty = "\033[2m" // Dim.
+)
+
+const (
+	keyEnter = '\r'
+	keyNewLine = '\n'
+	keyBackspace = 0x7f
+	keyCtrlH = 0x08
+	keyCtrlC = 0x03
+	keyCtrlD = 0x04
+	keyEscape = 0x1b
+)
+
+// Delay between the letters of a guess when its result is revealed.
+const tuiRevealDelay = 150 * time.Millisecond
+
+// Maximum number of message lines shown below the keyboard.
+const tuiMaxMessages = 20
+
+// Get an empty tile of the board.
+func emptyTile(theme Theme) string {
+	if theme.Colored() {
+		return tuiEmpty + " _ " + colorReset
+	}
+	return theme.Unused.Open + "_" + theme.Unused.Close
+}
+
+// Build the screen: the boards, the on-screen keyboard, the prompt and the messages, using ActiveTheme.
+// Only the first reveal letters of the last result are styled. A negative reveal styles every letter.
+// The boards of a multi-board round are drawn side by side, without a blank line between rows so they fit the screen.
+func (r *TuiRenderer) frame(gs *gengine.GameState, reveal int) string {
+	theme := ActiveTheme
+	round := gs.SaveState.CurrentGame
+	wordLength := round.GetWordLength()
+	boards := round.GetBoards()
+	tries := round.GetTries()
+	rowSeparator := "\n\n"
+	if round.IsMultiBoard() {
+		rowSeparator = "\n"
+	}
+	var sb strings.Builder
+
+	sb.WriteString("\n  G W O R D L E\n\n")
+
+	for start := 0; start < len(boards); start += boardsPerRow {
+		end := start + boardsPerRow
+		if end > len(boards) {
+			end = len(boards)
+		}
+		for row := 0; row < round.GetMaxTries(); row++ {
+			sb.WriteString("  ")
+			for _, board := range boards[start:end] {
+				for j := 0; j < wordLength; j++ {
+					switch {
+					case row < len(board.Results):
+						c := board.Results[row].Chars[j]
+						style := theme.Style(c.Status)
+						if reveal >= 0 && row == tries-1 && j >= reveal {
+							style = theme.Unused
+						}
+						sb.WriteString(style.TileLetter(c.Char) + " ")
+					case board.Win:
+						sb.WriteString("    ")
+					case row == tries && !r.typingCommand() && j < len(r.input):
+						sb.WriteString(theme.Unused.TileLetter(string(r.input[j])) + " ")
+					default:
+						sb.WriteString(emptyTile(theme) + " ")
+					}
+				}
+				sb.WriteString("  ")
+			}
+			sb.WriteString(rowSeparator)
+		}
+		if round.IsMultiBoard() {
+			sb.WriteString("\n")
+		}
+	}
+
+	statuses := round.GetLetterStatuses()
+	if reveal >= 0 && tries > 0 {
+		var results []wengine.ValidationResult
+		for _, board := range boards {
+			results = append(results, board.Results[:min(len(board.Results), tries-1)]...)
+		}
+		statuses = wengine.GetLetterStatuses(results)
+	}
+	for i, row := range keyboardLayout() {
+		sb.WriteString(strings.Repeat(" ", i+2))
+		if i == 2 {
+			sb.WriteString(theme.Unused.TileLetter("↵") + " ")
+		}
+		for _, key := range row {
+			sb.WriteString(theme.Style(statuses.Get(string(key))).TileLetter(string(key)) + " ")
+		}
+		if i == 2 {
+			sb.WriteString(theme.Unused.TileLetter("⌫"))
+		}
+		sb.WriteString("\n")
+	}
+
+	labels := localization.AppTranslatable
+	sb.WriteString("\n  " + fmt.Sprintf(labels.Tui.Instructions, labels.Commands.Help) + "\n")
+	remaining := round.RemainingAttempts
+	sb.WriteString("  " + fmt.Sprintf(labels.UserPrompt.RemainingAttempts.Select(remaining), remaining))
+	if r.typingCommand() {
+		sb.WriteString(string(r.input))
+	}
+	sb.WriteString("\n")
+
+	for _, message := range r.messages {
+		sb.WriteString("  " + message + "\n")
+	}
+	return sb.String()
+}
+
+// Returns true if the input is a command rather than a guess.
+func (r *TuiRenderer) typingCommand() bool {
+	return len(r.input) > 0 && r.input[0] == '/'
+}
+
+// Draw the screen in place.
+func (r *TuiRenderer) draw(gs *gengine.GameState, reveal int) {
+	if r.closed {
+		return
+	}
+	fmt.Fprint(r.out, tuiClearScreen+r.frame(gs, reveal))
+}
+
+// Add text to the messages, or write it directly once the terminal was restored.
+func (r *TuiRenderer) write(text string) {
+	if r.closed {
+		fmt.Fprint(r.out, text)
+		return
+	}
+	lines := strings.Split(text, "\n")
+	if len(r.messages) == 0 {
+		r.messages = []string{""}
+	}
+	r.messages[len(r.messages)-1] += lines[0]
+	r.messages = append(r.messages, lines[1:]...)
+	if len(r.messages) > tuiMaxMessages {
+		r.messages = r.messages[len(r.messages)-tuiMaxMessages:]
+	}
+}
+
+// Leave the full-screen UI and restore the terminal.
+// The messages are written out again, so the result of the last round stays visible on the main screen.
+func (r *TuiRenderer) close() {
+	if r.closed {
+		return
+	}
+	r.closed = true
+	fmt.Fprint(r.out, tuiLeaveScreen)
+	if r.restore != nil {
+		if err := r.restore(); err != nil {
+			log.Println(err)
+		}
+	}
+	if text := strings.Join(r.messages, "\n"); strings.TrimSpace(text) != "" {
+		fmt.Fprint(r.out, text)
+	}
+	r.messages = nil
+}
+
+// Renders the result of the word validation for the current round.
+// The result of a guess that was just submitted is revealed one letter at a time.
+func (r *TuiRenderer) RenderValidationResults(gs *gengine.GameState) {
+	round := gs.SaveState.CurrentGame
+	if r.reveal && round.GetTries() == r.resultCount+1 {
+		for i := 0; i < round.GetWordLength(); i++ {
+			r.draw(gs, i)
+			time.Sleep(tuiRevealDelay)
+		}
+	}
+	r.reveal = false
+	r.draw(gs, -1)
+}
+
+// Renders the boards of a multi-board round side by side, revealing the guess that was just submitted on each board.
+func (r *TuiRenderer) RenderBoards(gs *gengine.GameState) {
+	r.RenderValidationResults(gs)
+}
+
+// Renders the current game score.
+func (r *TuiRenderer) RenderGameScore(gs *gengine.GameState) {
+	CliRenderer{}.RenderGameScore(gs)
+}
+
+// Renders text inline,with string formatting.
+func (r *TuiRenderer) RenderText(format string, replacements ...interface{}) {
+	r.write(fmt.Sprintf(format, replacements...))
+}
+
+// Renders text and adds a new line to the add, with string formatting.
+func (r *TuiRenderer) RenderTextLn(format string, replacements ...interface{}) {
+	r.write(fmt.Sprintf(format, replacements...) + "\n")
+}
+
+// Read a key. Escape sequences, such as the arrow keys, are skipped and returned as zero.
+func (up *TuiUserPrompt) readKey() (rune, error) {
+	key, _, err := up.reader.ReadRune()
+	if err != nil {
+		return 0, err
+	}
+	if key != keyEscape {
+		return key, nil
+	}
+	for up.reader.Buffered() > 0 {
+		b, err := up.reader.ReadByte()
+		if err != nil || (b >= 0x40 && b <= 0x7e && b != '[' && b != 'O') {
+			break
+		}
+	}
+	return 0, nil
+}
+
+// Get user input for a guess word or a command.
+// Letters are typed into the board up to the word length. A slash starts a command instead.
+// Returns errExit on Ctrl+C, Ctrl+D, or once the input ends.
+func (up *TuiUserPrompt) GetUserInput(gs *gengine.GameState) (string, error) {
+	r := up.renderer
+	for {
+		r.draw(gs, -1)
+		key, err := up.readKey()
+		if err != nil {
+			return "", errExit
+		}
+
+		switch {
+		case key == keyEnter || key == keyNewLine:
+			if len(r.input) == 0 {
+				continue
+			}
+			input := string(r.input)
+			r.input = nil
+			r.messages = nil
+			if input[0] != '/' {
+				r.reveal = true
+				r.resultCount = gs.SaveState.CurrentGame.GetTries()
+			}
+			return input, nil
+		case key == keyBackspace || key == keyCtrlH:
+			if len(r.input) > 0 {
+				r.input = r.input[:len(r.input)-1]
+			}
+		case key == keyCtrlC || key == keyCtrlD:
+			return "", errExit
+		case key == '/' && len(r.input) == 0:
+			r.input = append(r.input, key)
+		case unicode.IsLetter(key):
+			if r.typingCommand() || len(r.input) < gs.SaveState.CurrentGame.GetWordLength() {
+				r.input = append(r.input, unicode.ToLower(key))
+			}
+		case r.typingCommand() && (unicode.IsDigit(key) || key == ' '):
+			r.input = append(r.input, key)
+		}
+	}
+}
+
+// Steps through a finished round, drawing each step in place.
+func (up *TuiUserPrompt) ReplayRound(gs *gengine.GameState, args []string) {
+	replayRound(gs, args, up.nextReplayStep)
+}
+
+// Draws the replayed step and waits for a key. Returns true for Enter, and false for any other key.
+// The last step is also kept on screen until a key is pressed, since the game is drawn again afterwards.
+func (up *TuiUserPrompt) nextReplayStep(replay *gengine.GameState, last bool) bool {
+	r := up.renderer
+	r.draw(replay, -1)
+	key, err := up.readKey()
+	r.messages = nil
+	return err == nil && (key == keyEnter || key == keyNewLine)
+}
+
+// Hides the current game with some dummy logs, until the user types the return or exit word.
+// Returns errExit if the user exits.
+func (up *TuiUserPrompt) HideGame(gs *gengine.GameState) error {
+	hideRound := localization.AppTranslatable.HideRound
+	out := up.renderer.out
+	message := hideRound.Instructions
+	var input []rune
+	fmt.Fprint(out, tuiShowCursor)
+	defer fmt.Fprint(out, tuiHideCursor)
+	for {
+		fmt.Fprintf(out, "%s%s\n%s\n%s", tuiClearScreen, hideBlock, message, string(input))
+		key, err := up.readKey()
+		if err != nil {
+			return errExit
+		}
+
+		switch {
+		case key == keyEnter || key == keyNewLine:
+			switch strings.ToLower(string(input)) {
+			case strings.ToLower(hideRound.Return):
+				return nil
+			case strings.ToLower(hideRound.Exit):
+				return errExit
+			}
+			input = nil
+			message = fmt.Sprintf("%s %s", hideRound.InvalidInput, hideRound.Instructions)
+		case key == keyBackspace || key == keyCtrlH:
+			if len(input) > 0 {
+				input = input[:len(input)-1]
+			}
+		case key == keyCtrlC || key == keyCtrlD:
+			return errExit
+		case unicode.IsLetter(key):
+			input = append(input, key)
+		}
+	}
+}
+
+// Display a message when a user exists the game. The terminal is restored first.
+func (up *TuiUserPrompt) ExitGameMessage(gs *gengine.GameState) {
+	up.renderer.close()
+	up.CliUserPrompt.ExitGameMessage(gs)
+}
+
+// Starts a local game in the full-screen UI.
+// Falls back to the line based UI when stdin or stdout is not a terminal.
+func InitTuiGame() {
+	stdin := int(os.Stdin.Fd())
+	if !isTerminal(stdin) || !isTerminal(int(os.Stdout.Fd())) {
+		log.Println("The full-screen UI needs a terminal. Using the line based UI instead.")
+		InitCliGame()
+		return
+	}
+	setupDictionary()
+	if err := SetupTheme(); err != nil {
+		log.Fatalln(err)
+	}
+	restore, err := enableRawMode(stdin)
+	if err != nil {
+		log.Println(err)
+		startCliGame()
+		return
+	}
+
+	r := &TuiRenderer{
+		out: os.Stdout,
+		restore: restore,
+	}
+	up := &TuiUserPrompt{
+		renderer: r,
+		reader: bufio.NewReader(os.Stdin),
+	}
+	fmt.Fprint(r.out, tuiEnterScreen)
+	defer r.close()
+	cg := &CliGame{
+		Prompt: up,
+		Renderer: r,
+		MemoryCard: CliMemoryCard{},
+	}
+	cg.Play()
+}
@@ -2,37 +2,74 @@ package config
 
 import (
 	"flag"
+	"time"
 
 	"golang.org/x/text/language"
 )
 
 type appConfig struct {
-	// Current application language. Default is English.
+	// Requested application language, as a BCP-47 tag. Default is English.
+	// Each package uses the closest locale it supports, see MatchLocale.
 	Locale language.Tag
 	// User configurations.
 	UserConfig userConfig
-	// API endpoint for dictionary lookup.
+	// Address of a remote gwordle gRPC server to play against. Empty to play locally.
+	RemoteAddr string
+	// Interactive front end: cli for line based prompts, tui for the full-screen terminal UI.
+	UI string
+	// Protocol of the interactive front end: text for prompts, or jsonl for one JSON command and event per line.
+	Protocol string
+	// Colors used to draw the board and keyboard. Color is disabled when NO_COLOR is set or stdout is not a terminal.
+	Theme string
+	// Dictionary used to check words that are not in the word list: api, file or none.
+	DictionaryProvider string
+	// API endpoint for dictionary lookup, used by the api provider.
 	DictionaryApiEndpoint string
-	// Current application version.
-	// TODO: Inject at build time automatically.
+	// Local dictionary file, used by the file provider.
+	DictionaryFile string
+	// How long dictionary lookups are cached on disk. Zero disables the cache.
+	DictionaryCacheTTL time.Duration
+	// Current application version. Set at build time, see version.
 	Version string
+	// Configuration file given with -config. Empty to use GWORDLE_CONFIG or look for one in DefaultConfigDir.
+	ConfigFile string
 }
 
 type userConfig struct {
 	MaxTries int // The maximum number of guesses allowed in a game.
 	WordLength int // The length of the guess word.
+	HardMode bool // Every guess must reuse the hints revealed by previous guesses.
+	Daily bool // Play the daily puzzle. The secret word is picked from the date instead of at random.
+	ShareFile string // File the /share command appends results to. Empty to only print them.
+	FoldAccents bool // Treat accented characters as their base character. Example: "á" is treated as "a".
+	Boards int // Number of boards played at once, each with its own secret word: 1, 2, 4 or 8.
+	Absurdle bool // Adversarial mode. The secret word is not picked up front, and dodges each guess for as long as it can.
 }
 
 var GlobalConfig appConfig
 
+// Application version, injected at build time with:
+// go build -ldflags "-X github.com/tanmancan/gwordle/v1/internal/config.version=1.2.3"
+var version = "0.0.1"
+
 func init() {
-	GlobalConfig.Version = "0.0.1"
-	GlobalConfig.Locale = language.English
-	GlobalConfig.DictionaryApiEndpoint = "https://api.dictionaryapi.dev/api/v2/entries/en/%s"
+	GlobalConfig.Version = version
+	flag.TextVar(&GlobalConfig.Locale, "lang", language.English, "Language as a BCP-47 tag, for example es or es-MX. Falls back to the closest supported language, then English.")
+	flag.StringVar(&GlobalConfig.ConfigFile, "config", "", "Configuration file in TOML, JSON or YAML format. Defaults to config.toml, config.json, config.yaml or config.yml in the gwordle user config directory.")
+	flag.StringVar(&GlobalConfig.DictionaryProvider, "dict", "api", "Dictionary used to check words that are not in the word list: api, file or none.")
+	flag.StringVar(&GlobalConfig.DictionaryApiEndpoint, "dict-endpoint", "https://api.dictionaryapi.dev/api/v2/entries/{lang}/%s", "Dictionary API endpoint used by the api dictionary. {lang} is replaced by the language and %s by the word.")
+	flag.StringVar(&GlobalConfig.DictionaryFile, "dict-file", "", "Local dictionary file used by the file dictionary. Either a JSON list of definitions or one word per line.")
+	flag.DurationVar(&GlobalConfig.DictionaryCacheTTL, "dict-cache-ttl", 30*24*time.Hour, "How long dictionary lookups are cached on disk. 0 disables the cache.")
+	flag.StringVar(&GlobalConfig.RemoteAddr, "remote", "", "Play against the gwordle gRPC server at this address, for example localhost:50051.")
+	flag.StringVar(&GlobalConfig.Protocol, "protocol", "text", "Protocol on stdin and stdout: text for prompts, or jsonl to read one JSON command per line and write one JSON event per line.")
+	flag.StringVar(&GlobalConfig.Theme, "theme", "default", "Colors of the board and keyboard: default, colorblind, high-contrast or monochrome. Color is disabled when NO_COLOR is set or the output is not a terminal.")
+	flag.StringVar(&GlobalConfig.UI, "ui", "cli", "Interactive front end: cli for line based prompts, tui for the full-screen terminal UI with an on-screen keyboard.")
 	flag.IntVar(&GlobalConfig.UserConfig.MaxTries, "tries", 6, "Maximum number of tries. Default is 6.")
 	flag.IntVar(&GlobalConfig.UserConfig.WordLength, "wlen", 5, "The word length. Default is 5")
-}
-
-func main() {
-	flag.Parse()
+	flag.BoolVar(&GlobalConfig.UserConfig.HardMode, "hard", false, "Enable hard mode. Revealed hints must be used in every following guess.")
+	flag.BoolVar(&GlobalConfig.UserConfig.Daily, "daily", false, "Play the daily puzzle. Everyone gets the same word on the same day.")
+	flag.StringVar(&GlobalConfig.UserConfig.ShareFile, "share-file", "", "Append results shared with /share to this file.")
+	flag.BoolVar(&GlobalConfig.UserConfig.Absurdle, "absurdle", false, "Adversarial mode. The secret word is not picked up front: after each guess, the game keeps the largest group of words that match the feedback, until only one word is left.")
+	flag.IntVar(&GlobalConfig.UserConfig.Boards, "boards", 1, "Number of boards played at once: 1, 2, 4 or 8. Every guess is validated against each board, and every board after the first adds a try.")
+	flag.BoolVar(&GlobalConfig.UserConfig.FoldAccents, "fold-accents", false, "Ignore accents when comparing letters. Example: á is treated as a.")
 }
@@ -0,0 +1,235 @@
+package config
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"unicode"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// Where the effective value of an option came from.
+type Source string
+
+const (
+	SourceDefault Source = "default"
+	SourceFile Source = "file"
+	SourceEnv Source = "env"
+	SourceFlag Source = "flag"
+	SourceBuild Source = "build"
+)
+
+// Prefix of the environment variables that override the configuration file.
+const EnvPrefix = "GWORDLE_"
+
+// Environment variable with the path of the configuration file.
+const ConfigFileEnv = EnvPrefix + "CONFIG"
+
+// An option that can be set from the configuration file, an environment variable or a command line flag.
+type setting struct {
+	Key string // Key in the configuration file. Example: wordLength
+	Flag string // Name of the command line flag bound to the option. Example: wlen
+}
+
+// Options that can be configured, in the order they are shown by WriteEffectiveConfig.
+var settings = []setting{
+	{Key: "locale", Flag: "lang"},
+	{Key: "dictionaryProvider", Flag: "dict"},
+	{Key: "dictionaryApiEndpoint", Flag: "dict-endpoint"},
+	{Key: "dictionaryFile", Flag: "dict-file"},
+	{Key: "dictionaryCacheTTL", Flag: "dict-cache-ttl"},
+	{Key: "remoteAddr", Flag: "remote"},
+	{Key: "ui", Flag: "ui"},
+	{Key: "theme", Flag: "theme"},
+	{Key: "protocol", Flag: "protocol"},
+	{Key: "maxTries", Flag: "tries"},
+	{Key: "wordLength", Flag: "wlen"},
+	{Key: "hardMode", Flag: "hard"},
+	{Key: "daily", Flag: "daily"},
+	{Key: "shareFile", Flag: "share-file"},
+	{Key: "foldAccents", Flag: "fold-accents"},
+	{Key: "boards", Flag: "boards"},
+	{Key: "absurdle", Flag: "absurdle"},
+}
+
+// Get the environment variable for the option. Example: wordLength is GWORDLE_WORD_LENGTH
+func (s setting) Env() string {
+	var sb strings.Builder
+	sb.WriteString(EnvPrefix)
+	runes := []rune(s.Key)
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) && unicode.IsLower(runes[i-1]) {
+			sb.WriteRune('_')
+		}
+		sb.WriteRune(unicode.ToUpper(r))
+	}
+	return sb.String()
+}
+
+// Path of the configuration file that was loaded. Empty if none was found.
+var ConfigFile string
+
+// Source of the effective value of each option, keyed by the option's key.
+var Sources = make(map[string]Source)
+
+// Extensions of the configuration file, in the order they are looked for.
+var configFileExtensions = []string{".toml", ".json", ".yaml", ".yml"}
+
+// Get the directory of the configuration file: <user config dir>/gwordle
+func DefaultConfigDir() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "gwordle"), nil
+}
+
+// Find the configuration file in the given directory: config.toml, config.json, config.yaml or config.yml
+// Returns an empty path if none exists.
+func findConfigFile(dir string) string {
+	for _, ext := range configFileExtensions {
+		path := filepath.Join(dir, "config"+ext)
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+	return ""
+}
+
+// Read a TOML, JSON or YAML configuration file, depending on its extension.
+// The values are returned as strings, keyed by option key, so they can be parsed by the bound flags.
+func readConfigFile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := make(map[string]interface{})
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".toml":
+		err = toml.Unmarshal(data, &raw)
+	case ".json":
+		err = json.Unmarshal(data, &raw)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &raw)
+	default:
+		err = fmt.Errorf("unsupported configuration file format %q", filepath.Ext(path))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	values := make(map[string]string)
+	for key, value := range raw {
+		values[key] = fmt.Sprint(value)
+	}
+	return values, nil
+}
+
+// Apply the configuration layers to the flags, in order of precedence: flags, environment variables, configuration file, defaults.
+// Returns the source of each option and the path of the configuration file that was loaded.
+func loadLayers(flags *flag.FlagSet, args []string, lookupEnv func(string) (string, bool)) (map[string]Source, string, error) {
+	if err := flags.Parse(args); err != nil {
+		return nil, "", err
+	}
+
+	fromFlags := make(map[string]bool)
+	flags.Visit(func(f *flag.Flag) {
+		fromFlags[f.Name] = true
+	})
+
+	configFile := ""
+	if f := flags.Lookup("config"); f != nil {
+		configFile = f.Value.String()
+	}
+	if configFile == "" {
+		configFile, _ = lookupEnv(ConfigFileEnv)
+	}
+	if configFile == "" {
+		if dir, err := DefaultConfigDir(); err == nil {
+			configFile = findConfigFile(dir)
+		}
+	}
+
+	fileValues := make(map[string]string)
+	if configFile != "" {
+		values, err := readConfigFile(configFile)
+		if err != nil {
+			return nil, "", err
+		}
+		fileValues = values
+	}
+
+	known := make(map[string]bool)
+	sources := make(map[string]Source)
+	for _, s := range settings {
+		known[s.Key] = true
+		sources[s.Key] = SourceDefault
+		if fromFlags[s.Flag] {
+			sources[s.Key] = SourceFlag
+			continue
+		}
+		if value, ok := lookupEnv(s.Env()); ok {
+			if err := flags.Set(s.Flag, value); err != nil {
+				return nil, "", fmt.Errorf("%s=%q: %w", s.Env(), value, err)
+			}
+			sources[s.Key] = SourceEnv
+			continue
+		}
+		if value, ok := fileValues[s.Key]; ok {
+			if err := flags.Set(s.Flag, value); err != nil {
+				return nil, "", fmt.Errorf("%s: %s = %q: %w", configFile, s.Key, value, err)
+			}
+			sources[s.Key] = SourceFile
+		}
+	}
+
+	for key := range fileValues {
+		if !known[key] {
+			return nil, "", fmt.Errorf("%s: unknown option %q", configFile, key)
+		}
+	}
+
+	return sources, configFile, nil
+}
+
+// Load the configuration from the command line arguments, environment variables and configuration file.
+// Flags override GWORDLE_* environment variables, which override the configuration file, which overrides the defaults.
+// The configuration file is given with -config or GWORDLE_CONFIG, or found in DefaultConfigDir.
+func Load(args []string) error {
+	sources, path, err := loadLayers(flag.CommandLine, args, os.LookupEnv)
+	if err != nil {
+		return err
+	}
+	Sources = sources
+	ConfigFile = path
+	return nil
+}
+
+// Print the effective value of every option and where it came from.
+func WriteEffectiveConfig(w io.Writer) {
+	configFile := ConfigFile
+	if configFile == "" {
+		configFile = "(none)"
+	}
+	fmt.Fprintf(w, "Config file: %s\n\n", configFile)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "OPTION\tVALUE\tSOURCE\tENV\tFLAG\n")
+	fmt.Fprintf(tw, "version\t%s\t%s\t\t\n", GlobalConfig.Version, SourceBuild)
+	for _, s := range settings {
+		source, ok := Sources[s.Key]
+		if !ok {
+			source = SourceDefault
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t-%s\n", s.Key, flag.Lookup(s.Flag).Value, source, s.Env(), s.Flag)
+	}
+	tw.Flush()
+}
@@ -0,0 +1,180 @@
+package config
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// Create a flag set with the flags bound to every setting, and the -config flag.
+func testFlagSet(values map[string]*string) *flag.FlagSet {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.String("config", "", "")
+	for _, s := range settings {
+		values[s.Key] = fs.String(s.Flag, "default", "")
+	}
+	return fs
+}
+
+func TestSetting_Env(t *testing.T) {
+	tests := []struct {
+		name string
+		key  string
+		want string
+	}{
+		{
+			name: "Camel case key",
+			key:  "wordLength",
+			want: "GWORDLE_WORD_LENGTH",
+		},
+		{
+			name: "Key ending with an acronym",
+			key:  "dictionaryCacheTTL",
+			want: "GWORDLE_DICTIONARY_CACHE_TTL",
+		},
+		{
+			name: "Single word key",
+			key:  "daily",
+			want: "GWORDLE_DAILY",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (setting{Key: tt.key}).Env(); got != tt.want {
+				t.Errorf("setting.Env() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadLayers(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"config.toml": "wordLength = 6\nmaxTries = 8\nhardMode = true\n",
+		"config.json": `{"wordLength": 6, "maxTries": 8, "hardMode": true}`,
+		"config.yaml": "wordLength: 6\nmaxTries: 8\nhardMode: true\n",
+		"unknown.json": `{"wordLen": 6}`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name        string
+		args        []string
+		env         map[string]string
+		wantValues  map[string]string
+		wantSources map[string]Source
+		wantErr     bool
+	}{
+		{
+			name: "TOML file",
+			args: []string{"-config", filepath.Join(dir, "config.toml")},
+			wantValues: map[string]string{
+				"wordLength": "6",
+				"maxTries":   "8",
+				"hardMode":   "true",
+				"daily":      "default",
+			},
+			wantSources: map[string]Source{
+				"wordLength": SourceFile,
+				"maxTries":   SourceFile,
+				"hardMode":   SourceFile,
+				"daily":      SourceDefault,
+			},
+		},
+		{
+			name: "JSON file",
+			args: []string{"-config", filepath.Join(dir, "config.json")},
+			wantValues: map[string]string{
+				"wordLength": "6",
+				"maxTries":   "8",
+				"hardMode":   "true",
+			},
+			wantSources: map[string]Source{
+				"wordLength": SourceFile,
+				"maxTries":   SourceFile,
+				"hardMode":   SourceFile,
+			},
+		},
+		{
+			name: "YAML file from GWORDLE_CONFIG",
+			env: map[string]string{
+				"GWORDLE_CONFIG": filepath.Join(dir, "config.yaml"),
+			},
+			wantValues: map[string]string{
+				"wordLength": "6",
+				"maxTries":   "8",
+				"hardMode":   "true",
+			},
+			wantSources: map[string]Source{
+				"wordLength": SourceFile,
+				"maxTries":   SourceFile,
+				"hardMode":   SourceFile,
+			},
+		},
+		{
+			name: "Environment variables override the file and flags override environment variables",
+			args: []string{"-config", filepath.Join(dir, "config.toml"), "-tries", "4"},
+			env: map[string]string{
+				"GWORDLE_WORD_LENGTH": "7",
+				"GWORDLE_MAX_TRIES":   "5",
+			},
+			wantValues: map[string]string{
+				"wordLength": "7",
+				"maxTries":   "4",
+				"hardMode":   "true",
+			},
+			wantSources: map[string]Source{
+				"wordLength": SourceEnv,
+				"maxTries":   SourceFlag,
+				"hardMode":   SourceFile,
+			},
+		},
+		{
+			name:    "Unknown option in the file",
+			args:    []string{"-config", filepath.Join(dir, "unknown.json")},
+			wantErr: true,
+		},
+		{
+			name:    "Missing file",
+			args:    []string{"-config", filepath.Join(dir, "missing.toml")},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := make(map[string]*string)
+			fs := testFlagSet(values)
+			lookupEnv := func(key string) (string, bool) {
+				value, ok := tt.env[key]
+				return value, ok
+			}
+			sources, _, err := loadLayers(fs, tt.args, lookupEnv)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("loadLayers() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			gotValues := make(map[string]string)
+			gotSources := make(map[string]Source)
+			for key := range tt.wantValues {
+				gotValues[key] = *values[key]
+			}
+			for key := range tt.wantSources {
+				gotSources[key] = sources[key]
+			}
+			if !reflect.DeepEqual(gotValues, tt.wantValues) {
+				t.Errorf("loadLayers() values = %v, want %v", gotValues, tt.wantValues)
+			}
+			if !reflect.DeepEqual(gotSources, tt.wantSources) {
+				t.Errorf("loadLayers() sources = %v, want %v", gotSources, tt.wantSources)
+			}
+		})
+	}
+}
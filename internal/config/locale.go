@@ -0,0 +1,24 @@
+package config
+
+import (
+	"golang.org/x/text/language"
+)
+
+// Get the supported locale closest to the requested locale, usually GlobalConfig.Locale.
+// The first supported locale is used when nothing matches. Example: es-MX matches es, or en if es is not supported.
+func MatchLocale(requested language.Tag, supported []language.Tag) language.Tag {
+	if len(supported) == 0 {
+		return language.Und
+	}
+	_, idx, _ := language.NewMatcher(supported).Match(requested)
+	return supported[idx]
+}
+
+// Get the locales to fall back to for the given locale, from the most specific to the most general.
+// Example: es-MX returns es-MX, es
+func LocaleFallbacks(tag language.Tag) (fallbacks []language.Tag) {
+	for t := tag; t != language.Und; t = t.Parent() {
+		fallbacks = append(fallbacks, t)
+	}
+	return fallbacks
+}
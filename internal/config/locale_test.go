@@ -0,0 +1,91 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestMatchLocale(t *testing.T) {
+	supported := []language.Tag{language.English, language.Spanish}
+	tests := []struct {
+		name      string
+		requested language.Tag
+		supported []language.Tag
+		want      language.Tag
+	}{
+		{
+			name:      "Exact match",
+			requested: language.Spanish,
+			supported: supported,
+			want:      language.Spanish,
+		},
+		{
+			name:      "Regional locale falls back to its language",
+			requested: language.MustParse("es-MX"),
+			supported: supported,
+			want:      language.Spanish,
+		},
+		{
+			name:      "Unsupported language falls back to the first supported locale",
+			requested: language.German,
+			supported: supported,
+			want:      language.English,
+		},
+		{
+			name:      "Regional locale without its language falls back to the first supported locale",
+			requested: language.MustParse("es-MX"),
+			supported: []language.Tag{language.English},
+			want:      language.English,
+		},
+		{
+			name:      "Nothing supported",
+			requested: language.Spanish,
+			supported: nil,
+			want:      language.Und,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchLocale(tt.requested, tt.supported); got != tt.want {
+				t.Errorf("MatchLocale() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocaleFallbacks(t *testing.T) {
+	tests := []struct {
+		name string
+		tag  language.Tag
+		want []language.Tag
+	}{
+		{
+			name: "Language",
+			tag:  language.Spanish,
+			want: []language.Tag{language.Spanish},
+		},
+		{
+			name: "Regional locale",
+			tag:  language.MustParse("es-MX"),
+			want: []language.Tag{
+				language.MustParse("es-MX"),
+				language.MustParse("es-419"),
+				language.Spanish,
+			},
+		},
+		{
+			name: "Undefined",
+			tag:  language.Und,
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LocaleFallbacks(tt.tag); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LocaleFallbacks() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package dictionaryapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// A cached response for a single word.
+type cacheEntry struct {
+	Response []DictionaryApiDefinition // Definitions when the word was found.
+	Error DictionaryApiResponseError // Error when the word was definitely not found.
+	Expires time.Time
+}
+
+// Persistent on-disk cache of api.dictionaryapi.dev responses.
+// Both found words and definite misses are cached. Responses from an unavailable dictionary are never cached.
+type DefinitionCache struct {
+	Path string // JSON file the cache is stored in.
+	TTL time.Duration // How long an entry is kept before the dictionary is asked again.
+	mu sync.Mutex
+	entries map[string]cacheEntry
+}
+
+// Cache consulted by GetWordDefinition before any HTTP call. Nil disables caching.
+var ActiveCache *DefinitionCache
+
+// Get the default cache file path in the user's cache directory.
+// Each language has its own cache, since the same word can exist in one language and not another.
+func DefaultCachePath() (string, error) {
+	cdir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(cdir, "gwordle", fmt.Sprintf("dictionary-cache-%s.json", DictionaryLang())), nil
+}
+
+// Create a cache stored in the given file, loading any existing entries.
+// A missing or unreadable file starts an empty cache.
+func NewDefinitionCache(path string, ttl time.Duration) *DefinitionCache {
+	c := &DefinitionCache{
+		Path: path,
+		TTL: ttl,
+		entries: make(map[string]cacheEntry),
+	}
+	data, err := os.ReadFile(path)
+	if err == nil {
+		json.Unmarshal(data, &c.entries)
+	}
+	return c
+}
+
+// Enable the persistent cache for GetWordDefinition, stored in the default cache path.
+func EnableCache(ttl time.Duration) error {
+	path, err := DefaultCachePath()
+	if err != nil {
+		return err
+	}
+	ActiveCache = NewDefinitionCache(path, ttl)
+	return nil
+}
+
+// Get the cache key of a word looked up with the request. The URL of the request is part of the key,
+// so switching to another endpoint does not serve the entries cached from the previous one.
+func requestCacheKey(word string, request *http.Request) string {
+	return strings.ToLower(word) + " " + request.URL.String()
+}
+
+// Get the cached response for a key. Returns false if the key is not cached or the entry has expired.
+func (c *DefinitionCache) Get(key string) (DictionaryApiResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.Expires) {
+		return DictionaryApiResponse{}, false
+	}
+
+	return DictionaryApiResponse{
+		Response: entry.Response,
+		Error: entry.Error,
+	}, true
+}
+
+// Cache the response for a key and write the cache to disk.
+// Responses from an unavailable dictionary are ignored.
+func (c *DefinitionCache) Set(key string, response DictionaryApiResponse) error {
+	if response.Unavailable {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = cacheEntry{
+		Response: response.Response,
+		Error: response.Error,
+		Expires: time.Now().Add(c.TTL),
+	}
+
+	return c.save()
+}
+
+// Write the cache to disk. Expired entries are dropped.
+func (c *DefinitionCache) save() error {
+	now := time.Now()
+	for word, entry := range c.entries {
+		if now.After(entry.Expires) {
+			delete(c.entries, word)
+		}
+	}
+
+	data, err := json.Marshal(c.entries)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.Path), os.ModePerm); err != nil {
+		return err
+	}
+
+	tmp := fmt.Sprintf("%s.tmp", c.Path)
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, c.Path)
+}
@@ -0,0 +1,155 @@
+package dictionaryapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var cachedHit = DictionaryApiResponse{
+	Response: []DictionaryApiDefinition{
+		{
+			Word: "smile",
+		},
+	},
+}
+
+var cachedMiss = DictionaryApiResponse{
+	Error: DictionaryApiResponseError{
+		Title: "No Definitions Found",
+	},
+}
+
+func TestDefinitionCache(t *testing.T) {
+	type args struct {
+		word     string
+		response DictionaryApiResponse
+		ttl      time.Duration
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   DictionaryApiResponse
+		wantOk bool
+	}{
+		{
+			name: "Caches a found word",
+			args: args{
+				word:     "smile",
+				response: cachedHit,
+				ttl:      time.Hour,
+			},
+			want:   cachedHit,
+			wantOk: true,
+		},
+		{
+			name: "Caches a definite miss",
+			args: args{
+				word:     "xyzzy",
+				response: cachedMiss,
+				ttl:      time.Hour,
+			},
+			want:   cachedMiss,
+			wantOk: true,
+		},
+		{
+			name: "Does not cache an unavailable dictionary",
+			args: args{
+				word:     "smile",
+				response: unavailableResponse(http.ErrHandlerTimeout),
+				ttl:      time.Hour,
+			},
+			want:   DictionaryApiResponse{},
+			wantOk: false,
+		},
+		{
+			name: "Expired entries are ignored",
+			args: args{
+				word:     "smile",
+				response: cachedHit,
+				ttl:      -time.Hour,
+			},
+			want:   DictionaryApiResponse{},
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "cache.json")
+			c := NewDefinitionCache(path, tt.args.ttl)
+			if err := c.Set(tt.args.word, tt.args.response); err != nil {
+				t.Fatalf("DefinitionCache.Set() error = %v", err)
+			}
+
+			// Reload from disk to make sure the cache is persisted.
+			reloaded := NewDefinitionCache(path, tt.args.ttl)
+			got, ok := reloaded.Get(tt.args.word)
+			if ok != tt.wantOk {
+				t.Errorf("DefinitionCache.Get() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DefinitionCache.Get() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_GetWordDefinition_cached(t *testing.T) {
+	requests := 0
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer testServer.Close()
+
+	ActiveCache = NewDefinitionCache(filepath.Join(t.TempDir(), "cache.json"), time.Hour)
+	defer func() {
+		ActiveCache = nil
+	}()
+	request := TestGetWordDefinitionRequest{
+		TestServer: testServer,
+	}
+	ActiveCache.Set(requestCacheKey(request.GetWord(), request.BuildDictionaryRequest()), cachedHit)
+
+	if got := GetWordDefinition(request); !reflect.DeepEqual(got, cachedHit) {
+		t.Errorf("GetWordDefinition() = %v, want %v", got, cachedHit)
+	}
+	if requests != 0 {
+		t.Errorf("GetWordDefinition() made %d requests, want 0", requests)
+	}
+
+	// The same word looked up at another endpoint is not served from the entries of the first one.
+	otherServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer otherServer.Close()
+	other := TestGetWordDefinitionRequest{
+		TestServer: otherServer,
+	}
+	if got := GetWordDefinition(other); reflect.DeepEqual(got, cachedHit) {
+		t.Errorf("GetWordDefinition() at another endpoint = %v, want a new lookup", got)
+	}
+	if requests != 1 {
+		t.Errorf("GetWordDefinition() at another endpoint made %d requests, want 1", requests)
+	}
+}
+
+func Test_GetWordDefinition_unavailable(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	request := TestGetWordDefinitionRequest{
+		TestServer: testServer,
+	}
+	testServer.Close()
+
+	got := GetWordDefinition(request)
+	if !got.Unavailable {
+		t.Errorf("GetWordDefinition() Unavailable = %v, want %v", got.Unavailable, true)
+	}
+	if got.Found() {
+		t.Errorf("GetWordDefinition() Found() = %v, want %v", got.Found(), false)
+	}
+}
@@ -1,45 +1,60 @@
 package dictionaryapi
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/tanmancan/gwordle/v1/internal/config"
+	"golang.org/x/text/language"
 )
 
 // Response from api.dictionaryapi.dev
 type DictionaryApiResponse struct {
 	Response []DictionaryApiDefinition
 	Error DictionaryApiResponseError
+	Unavailable bool // The dictionary could not be reached, so it is unknown if the word exists.
+}
+
+// Returns true if the dictionary has a definition for the word.
+func (r DictionaryApiResponse) Found() bool {
+	return !r.Unavailable && len(r.Response) > 0
 }
 
 type WordDefinitions struct {
-	Definition string
-	Example string
+	Definition string `json:"definition"`
+	Example string `json:"example,omitempty"`
 }
 
 type WordMeanings struct {
-	PartOfSpeech string
-	Definitions []WordDefinitions
+	PartOfSpeech string `json:"partOfSpeech"`
+	Definitions []WordDefinitions `json:"definitions"`
 }
 
 type DictionaryApiDefinition struct {
-	Word string
-	Phonetic string
-	Origin string
-	Meanings []WordMeanings
+	Word string `json:"word"`
+	Phonetic string `json:"phonetic,omitempty"`
+	Origin string `json:"origin,omitempty"`
+	Meanings []WordMeanings `json:"meanings"`
 }
 
 // An error response from api.dictionaryapi.dev
 type DictionaryApiResponseError struct {
-	Title string
-	Message string
-	Resolution string
+	Title string `json:"title"`
+	Message string `json:"message"`
+	Resolution string `json:"resolution"`
 }
 
+// How long to wait for api.dictionaryapi.dev before treating it as unavailable.
+const requestTimeout = 5 * time.Second
+
 type DictionaryApiRequest interface {
 	GetWord() string
 	BuildDictionaryRequest() (*http.Request)
@@ -47,6 +62,23 @@ type DictionaryApiRequest interface {
 
 type GetWordDefinitionRequest struct {
 	Word string
+	Endpoint string // Endpoint template, where {lang} is replaced by Lang and %s by the word. Defaults to config.GlobalConfig.DictionaryApiEndpoint.
+	Lang string // Language of the word. Defaults to DictionaryLang.
+}
+
+// Locale of the loaded word list, set by wengine.LoadLocale. It can differ from config.GlobalConfig.Locale,
+// since a requested locale without a word list falls back to another one. Example: pt-BR loads the en word list.
+var WordListLocale language.Tag
+
+// Get the language used to look up words: the base language of WordListLocale, so definitions are in the language of the words.
+// Falls back to config.GlobalConfig.Locale before a word list is loaded. Example: es-MX uses es.
+func DictionaryLang() string {
+	locale := WordListLocale
+	if locale == language.Und {
+		locale = config.GlobalConfig.Locale
+	}
+	base, _ := locale.Base()
+	return base.String()
 }
 
 func (r GetWordDefinitionRequest) GetWord() string {
@@ -56,11 +88,21 @@ func (r GetWordDefinitionRequest) GetWord() string {
 // Build a request for api.dictionaryapi.dev for the provided word.
 func (r GetWordDefinitionRequest) BuildDictionaryRequest() (*http.Request) {
 	word := r.GetWord()
-	endpoint := fmt.Sprintf(config.GlobalConfig.DictionaryApiEndpoint, word)
+	endpointTemplate := r.Endpoint
+	if endpointTemplate == "" {
+		endpointTemplate = config.GlobalConfig.DictionaryApiEndpoint
+	}
+	lang := r.Lang
+	if lang == "" {
+		lang = DictionaryLang()
+	}
+	endpointTemplate = strings.ReplaceAll(endpointTemplate, "{lang}", url.PathEscape(lang))
+	endpoint := fmt.Sprintf(endpointTemplate, url.PathEscape(word))
 	request, err := http.NewRequest("GET", endpoint, nil)
 
 	if err != nil {
-		log.Fatalln(err)
+		log.Println(err)
+		return nil
 	}
 
 	return request
@@ -71,7 +113,7 @@ func parseDictionaryResponse(response *http.Response) (apiResponse DictionaryApi
 	body, err := ioutil.ReadAll(response.Body)
 
 	if err != nil {
-		log.Fatalln(err)
+		return unavailableResponse(err)
 	}
 
 	switch response.StatusCode {
@@ -85,22 +127,59 @@ func parseDictionaryResponse(response *http.Response) (apiResponse DictionaryApi
 			Message: fmt.Sprintf("Status: %s - %s", response.Status, string(body)),
 			Resolution: "Check https://github.com/meetDeveloper/freeDictionaryAPI/issues for any service issues.",
 		}
-
+		apiResponse.Unavailable = true
 	}
 
 	return apiResponse
 }
 
+// Build the response used when the dictionary could not be reached.
+func unavailableResponse(err error) DictionaryApiResponse {
+	return DictionaryApiResponse{
+		Error: DictionaryApiResponseError{
+			Title: "Dictionary unavailable.",
+			Message: err.Error(),
+			Resolution: "Check your network connection.",
+		},
+		Unavailable: true,
+	}
+}
+
 // Get the definition for the provided word using api.dictionaryapi.dev
+// ActiveCache is consulted first, if enabled. Network failures return a response marked as Unavailable.
 func GetWordDefinition(r DictionaryApiRequest) DictionaryApiResponse {
+	return getWordDefinition(context.Background(), r)
+}
+
+// Same as GetWordDefinition, but the request is cancelled along with the given context.
+func getWordDefinition(ctx context.Context, r DictionaryApiRequest) DictionaryApiResponse {
 	request := r.BuildDictionaryRequest()
-	client := http.Client{}
-	response, err := client.Do(request)
+	if request == nil {
+		return unavailableResponse(errors.New("Could not build the dictionary request."))
+	}
+	key := requestCacheKey(r.GetWord(), request)
+	if ActiveCache != nil {
+		if cached, ok := ActiveCache.Get(key); ok {
+			return cached
+		}
+	}
+	client := http.Client{
+		Timeout: requestTimeout,
+	}
+	response, err := client.Do(request.WithContext(ctx))
 
 	if err != nil {
-		log.Fatalln(err)
+		return unavailableResponse(err)
 	}
 	defer response.Body.Close()
 
-	return parseDictionaryResponse(response)
+	apiResponse := parseDictionaryResponse(response)
+
+	if ActiveCache != nil {
+		if err := ActiveCache.Set(key, apiResponse); err != nil {
+			log.Println(err)
+		}
+	}
+
+	return apiResponse
 }
@@ -179,6 +179,7 @@ func Test_GetWordDefinition_errorResponse_statusUnknown(t *testing.T) {
 					Message:  "Status: 400 Bad Request - Oops!\n",
 					Resolution: "Check https://github.com/meetDeveloper/freeDictionaryAPI/issues for any service issues.",
 				},
+				Unavailable: true,
 			},
 		},
 	}
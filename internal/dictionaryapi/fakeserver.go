@@ -0,0 +1,32 @@
+package dictionaryapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"path"
+)
+
+// Serves definitions from a FileProvider in the same JSON shape as api.dictionaryapi.dev.
+// The word is taken from the last path segment, so /api/v2/entries/en/smile looks up "smile".
+// Can be used with httptest.NewServer, or run as a standalone server with cmd/fakedict.
+type FakeServer struct {
+	Provider *FileProvider
+}
+
+func (fs FakeServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	word := path.Base(req.URL.Path)
+	def, found, _ := fs.Provider.Lookup(req.Context(), word)
+
+	w.Header().Set("Content-Type", "application/json")
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(DictionaryApiResponseError{
+			Title: "No Definitions Found",
+			Message: "Sorry pal, we couldn't find definitions for the word you were looking for.",
+			Resolution: "You can try the search again at later time or head to the web instead.",
+		})
+		return
+	}
+
+	json.NewEncoder(w).Encode([]DictionaryApiDefinition{*def})
+}
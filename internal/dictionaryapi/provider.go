@@ -0,0 +1,158 @@
+package dictionaryapi
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tanmancan/gwordle/v1/internal/config"
+)
+
+// Looks up word definitions from a dictionary.
+type DictionaryProvider interface {
+	// Returns the definition of the word and whether it was found.
+	// An error means the dictionary could not answer, so it is unknown if the word exists.
+	Lookup(ctx context.Context, word string) (definition *DictionaryApiDefinition, found bool, err error)
+}
+
+// Returned by NoneProvider, since no dictionary is configured.
+var ErrNoDictionary = errors.New("No dictionary is configured.")
+
+// Returned by ApiProvider when the dictionary could not be reached.
+var ErrDictionaryUnavailable = errors.New("The dictionary could not be reached.")
+
+// Looks up words using api.dictionaryapi.dev, or any endpoint returning the same JSON shape.
+// Responses are cached in ActiveCache, if enabled.
+type ApiProvider struct {
+	Endpoint string // Endpoint template, where {lang} is replaced by Lang and %s by the word.
+	Lang string // Language of the words. Defaults to DictionaryLang.
+}
+
+// Looks up the word using the dictionary API.
+func (p *ApiProvider) Lookup(ctx context.Context, word string) (*DictionaryApiDefinition, bool, error) {
+	request := GetWordDefinitionRequest{
+		Word: word,
+		Endpoint: p.Endpoint,
+		Lang: p.Lang,
+	}
+	response := getWordDefinition(ctx, request)
+
+	if response.Unavailable {
+		return nil, false, fmt.Errorf("%s: %w", response.Error.Message, ErrDictionaryUnavailable)
+	}
+	if !response.Found() {
+		return nil, false, nil
+	}
+
+	return &response.Response[0], true, nil
+}
+
+// Looks up words in a local file.
+// JSON files use the same shape as the api.dictionaryapi.dev response: a list of definitions.
+// Any other file is read as a list of words, one per line, without definitions.
+type FileProvider struct {
+	Definitions map[string]DictionaryApiDefinition
+}
+
+// Load a local dictionary file.
+func NewFileProvider(path string) (*FileProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &FileProvider{
+		Definitions: make(map[string]DictionaryApiDefinition),
+	}
+
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		var definitions []DictionaryApiDefinition
+		if err := json.Unmarshal(data, &definitions); err != nil {
+			return nil, err
+		}
+		for _, def := range definitions {
+			p.Definitions[strings.ToLower(def.Word)] = def
+		}
+		return p, nil
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for scanner.Scan() {
+		word := strings.TrimSpace(scanner.Text())
+		if word != "" {
+			p.Definitions[strings.ToLower(word)] = DictionaryApiDefinition{
+				Word: word,
+			}
+		}
+	}
+
+	return p, scanner.Err()
+}
+
+// Looks up the word in the local file.
+func (p *FileProvider) Lookup(ctx context.Context, word string) (*DictionaryApiDefinition, bool, error) {
+	def, ok := p.Definitions[strings.ToLower(word)]
+	if !ok {
+		return nil, false, nil
+	}
+	return &def, true, nil
+}
+
+// Used when no dictionary is configured. Only the built-in word list is used.
+type NoneProvider struct{}
+
+// Always returns ErrNoDictionary.
+func (p NoneProvider) Lookup(ctx context.Context, word string) (*DictionaryApiDefinition, bool, error) {
+	return nil, false, ErrNoDictionary
+}
+
+// Names of the available dictionary providers.
+const (
+	ProviderApi = "api"
+	ProviderFile = "file"
+	ProviderNone = "none"
+)
+
+// Create the dictionary provider with the given name.
+// endpoint is used by the api provider and path by the file provider.
+func NewProvider(name string, endpoint string, path string) (DictionaryProvider, error) {
+	switch name {
+	case ProviderApi, "":
+		return &ApiProvider{
+			Endpoint: endpoint,
+		}, nil
+	case ProviderFile:
+		return NewFileProvider(path)
+	case ProviderNone:
+		return NoneProvider{}, nil
+	default:
+		return nil, fmt.Errorf("Unknown dictionary provider: %s", name)
+	}
+}
+
+// Provider used by Lookup. Defaults to the api provider with the configured endpoint.
+var ActiveProvider DictionaryProvider = &ApiProvider{}
+
+// Replace ActiveProvider with the provider selected in the config.
+func SetupProvider() error {
+	p, err := NewProvider(
+		config.GlobalConfig.DictionaryProvider,
+		config.GlobalConfig.DictionaryApiEndpoint,
+		config.GlobalConfig.DictionaryFile,
+	)
+	if err != nil {
+		return err
+	}
+	ActiveProvider = p
+	return nil
+}
+
+// Look up the word using ActiveProvider.
+func Lookup(word string) (*DictionaryApiDefinition, bool, error) {
+	return ActiveProvider.Lookup(context.Background(), word)
+}
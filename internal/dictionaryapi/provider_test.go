@@ -0,0 +1,156 @@
+package dictionaryapi
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const (
+	mockDefinitionsPath = "test-mocks/dictionaryapimocks/success-response.json"
+	mockWordsPath       = "test-mocks/dictionaryapimocks/words.txt"
+)
+
+func mustFileProvider(t *testing.T, path string) *FileProvider {
+	p, err := NewFileProvider(path)
+	if err != nil {
+		t.Fatalf("NewFileProvider() error = %v", err)
+	}
+	return p
+}
+
+func TestDictionaryProvider_Lookup(t *testing.T) {
+	fakeServer := httptest.NewServer(FakeServer{
+		Provider: mustFileProvider(t, mockDefinitionsPath),
+	})
+	defer fakeServer.Close()
+
+	unreachableServer := httptest.NewServer(FakeServer{})
+	unreachableServer.Close()
+
+	tests := []struct {
+		name          string
+		provider      DictionaryProvider
+		word          string
+		wantWord      string
+		wantFound     bool
+		wantErr       error
+		wantErrSuffix string
+	}{
+		{
+			name:      "File provider finds a word with definitions",
+			provider:  mustFileProvider(t, mockDefinitionsPath),
+			word:      "smile",
+			wantWord:  "smile",
+			wantFound: true,
+		},
+		{
+			name:      "File provider finds a word from a word list",
+			provider:  mustFileProvider(t, mockWordsPath),
+			word:      "crane",
+			wantWord:  "Crane",
+			wantFound: true,
+		},
+		{
+			name:      "File provider does not find a missing word",
+			provider:  mustFileProvider(t, mockWordsPath),
+			word:      "xyzzy",
+			wantFound: false,
+		},
+		{
+			name: "Api provider finds a word served by the fake server",
+			provider: &ApiProvider{
+				Endpoint: fakeServer.URL + "/api/v2/entries/en/%s",
+			},
+			word:      "smile",
+			wantWord:  "smile",
+			wantFound: true,
+		},
+		{
+			name: "Api provider does not find a word missing from the fake server",
+			provider: &ApiProvider{
+				Endpoint: fakeServer.URL + "/api/v2/entries/en/%s",
+			},
+			word:      "xyzzy",
+			wantFound: false,
+		},
+		{
+			name: "Api provider reports an unreachable server",
+			provider: &ApiProvider{
+				Endpoint: unreachableServer.URL + "/api/v2/entries/en/%s",
+			},
+			word:          "smile",
+			wantFound:     false,
+			wantErr:       ErrDictionaryUnavailable,
+			wantErrSuffix: ": " + ErrDictionaryUnavailable.Error(),
+		},
+		{
+			name:      "None provider never finds a word",
+			provider:  NoneProvider{},
+			word:      "smile",
+			wantFound: false,
+			wantErr:   ErrNoDictionary,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			def, found, err := tt.provider.Lookup(context.Background(), tt.word)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Lookup() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !strings.HasSuffix(err.Error(), tt.wantErrSuffix) {
+				t.Errorf("Lookup() error = %q, want it to end with %q", err, tt.wantErrSuffix)
+			}
+			if found != tt.wantFound {
+				t.Errorf("Lookup() found = %v, want %v", found, tt.wantFound)
+			}
+			if found && def.Word != tt.wantWord {
+				t.Errorf("Lookup() word = %v, want %v", def.Word, tt.wantWord)
+			}
+		})
+	}
+}
+
+func TestNewProvider(t *testing.T) {
+	tests := []struct {
+		name         string
+		providerName string
+		path         string
+		wantErr      bool
+	}{
+		{
+			name:         "Api provider",
+			providerName: ProviderApi,
+		},
+		{
+			name:         "File provider",
+			providerName: ProviderFile,
+			path:         mockWordsPath,
+		},
+		{
+			name:         "File provider with a missing file",
+			providerName: ProviderFile,
+			path:         "test-mocks/missing.json",
+			wantErr:      true,
+		},
+		{
+			name:         "None provider",
+			providerName: ProviderNone,
+		},
+		{
+			name:         "Unknown provider",
+			providerName: "thesaurus",
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewProvider(tt.providerName, "", tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewProvider() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
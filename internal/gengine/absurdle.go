@@ -0,0 +1,99 @@
+package gengine
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/tanmancan/gwordle/v1/internal/wengine"
+	"github.com/tanmancan/gwordle/v1/internal/wengine/solver"
+)
+
+// Returned when the adversarial mode is combined with more than one board.
+var ErrAbsurdleBoards = errors.New("The adversarial mode can only be played with a single board.")
+
+// Start the candidates of an adversarial round with every word of the given length.
+// The secret word is only a placeholder until the candidates are narrowed down to a single word.
+func newAbsurdleCandidates(wordLength int) []string {
+	return wengine.WordListCache.FilterWordList(wengine.WordListCache.Words[wordLength])
+}
+
+// Rebuild the candidates of the current adversarial round from its results, after a guess was undone.
+// The words that match every remaining result are the ones the adversary kept.
+func (gs *GameState) rebuildAbsurdleCandidates() {
+	round := &gs.SaveState.CurrentGame
+	round.Candidates = solver.FilterCandidates(newAbsurdleCandidates(round.GetWordLength()), round.Results, wengine.ValidationOptions{
+		FoldAccents: gs.FoldAccents,
+	})
+	if len(round.Candidates) > 0 {
+		round.SecretWord = round.Candidates[0]
+	}
+}
+
+// Splits the candidates by the feedback pattern the guess word would get against each of them.
+// Returns the patterns, sorted from the bucket the adversary prefers most, and the candidates of each pattern.
+func absurdleBuckets(word string, candidates []string, options wengine.ValidationOptions) ([]string, map[string][]string) {
+	buckets := make(map[string][]string)
+	for _, candidate := range candidates {
+		result, err := wengine.ValidateWordWithOptions(word, candidate, options)
+		if err != nil {
+			continue
+		}
+		pattern := solver.Pattern(result)
+		buckets[pattern] = append(buckets[pattern], candidate)
+	}
+
+	var patterns []string
+	for pattern := range buckets {
+		patterns = append(patterns, pattern)
+	}
+	// The largest bucket is kept. Ties go to the pattern that reveals the least,
+	// so the winning pattern is only picked once it is the last one left.
+	sort.Slice(patterns, func(i, j int) bool {
+		a, b := patterns[i], patterns[j]
+		if len(buckets[a]) != len(buckets[b]) {
+			return len(buckets[a]) > len(buckets[b])
+		}
+		if revealed(a) != revealed(b) {
+			return revealed(a) < revealed(b)
+		}
+		return a < b
+	})
+	return patterns, buckets
+}
+
+// Scores how much a feedback pattern reveals: two points for each letter in the correct position, and one for each letter in the word.
+func revealed(pattern string) int {
+	return 2*strings.Count(pattern, "V") + strings.Count(pattern, "P")
+}
+
+// Validates the guess word in an adversarial round.
+// The remaining candidates are narrowed down to the largest bucket of words that share a feedback pattern for the guess,
+// and one of them becomes the secret word the guess is validated against. The guess only matches once it is the last candidate.
+func (gs *GameState) validateAbsurdle(word string) (bool, error) {
+	round := &gs.SaveState.CurrentGame
+	options := wengine.ValidationOptions{
+		FoldAccents: gs.FoldAccents,
+	}
+
+	if _, err := wengine.ValidateWordWithOptions(word, round.SecretWord, options); err != nil {
+		return false, err
+	}
+
+	patterns, buckets := absurdleBuckets(word, round.Candidates, options)
+	if len(patterns) > 0 {
+		round.Candidates = buckets[patterns[0]]
+		round.SecretWord = round.Candidates[0]
+	}
+
+	result, _ := wengine.ValidateWordWithOptions(word, round.SecretWord, options)
+
+	round.RemainingAttempts -= 1
+	round.Results = append(round.Results, result)
+	round.logEvent(RoundEvent{
+		Type: GuessSubmitted,
+		Word: word,
+		Results: []wengine.ValidationResult{result},
+	})
+	return result.Match, nil
+}
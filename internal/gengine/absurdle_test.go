@@ -0,0 +1,65 @@
+package gengine
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGame_absurdle(t *testing.T) {
+	tests := []struct {
+		name           string
+		candidates     []string
+		words          []string
+		want           bool
+		wantCandidates []string
+	}{
+		{
+			name:           "Keeps the largest bucket",
+			candidates:     []string{"hello", "poise", "spore", "store"},
+			words:          []string{"hello"},
+			want:           false,
+			wantCandidates: []string{"poise", "spore", "store"},
+		},
+		{
+			name:           "Ties go to the pattern that reveals the least",
+			candidates:     []string{"crate", "hello", "trace"},
+			words:          []string{"crate"},
+			want:           false,
+			wantCandidates: []string{"hello"},
+		},
+		{
+			name:           "Matches the last candidate and clears the candidates",
+			candidates:     []string{"crate", "hello", "trace"},
+			words:          []string{"crate", "hello"},
+			want:           true,
+			wantCandidates: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game := resumeTestGame(t, Options{Absurdle: true}, GameRound{
+				SecretWord:        tt.candidates[0],
+				RemainingAttempts: 6,
+				Absurdle:          true,
+				Candidates:        tt.candidates,
+			})
+			var result Result
+			for _, word := range tt.words {
+				var err error
+				if result, err = game.Guess(word); err != nil {
+					t.Fatalf("Game.Guess(%q) error = %v", word, err)
+				}
+			}
+			round := result.Round
+			if result.Win != tt.want {
+				t.Errorf("Game.Guess() win = %v, want %v", result.Win, tt.want)
+			}
+			if !reflect.DeepEqual(round.Candidates, tt.wantCandidates) {
+				t.Errorf("Candidates = %v, want %v", round.Candidates, tt.wantCandidates)
+			}
+			if len(round.Results) != len(tt.words) {
+				t.Errorf("got %d results, want %d", len(round.Results), len(tt.words))
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package gengine
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tanmancan/gwordle/v1/internal/config"
+	"github.com/tanmancan/gwordle/v1/internal/wengine"
+)
+
+// Numbers of boards a game can be played with.
+var BoardCounts = []int{1, 2, 4, 8}
+
+// Returned when a game is configured with a number of boards other than BoardCounts.
+var ErrInvalidBoards = errors.New("The number of boards must be 1, 2, 4 or 8.")
+
+// Returned when hard mode is combined with more than one board.
+var ErrHardModeBoards = errors.New("Hard mode can only be played with a single board.")
+
+// One of the secret words of a multi-board round, and the results of the guesses validated against it.
+// Guesses are no longer validated against a board once it is solved.
+type Board struct {
+	SecretWord string
+	Results []wengine.ValidationResult
+	Win bool // If the board was solved.
+}
+
+// Returns the number of boards used for new rounds.
+// Defaults to AppConfig.UserConfig.Boards.
+func (gs *GameState) GetBoardCount() int {
+	if gs.Boards != 0 {
+		return gs.Boards
+	}
+	return config.GlobalConfig.UserConfig.Boards
+}
+
+// Get the boards of the round.
+// A single-board round has one board, made from its secret word and results.
+func (gr *GameRound) GetBoards() []Board {
+	if len(gr.Boards) > 0 {
+		return gr.Boards
+	}
+	return []Board{{
+		SecretWord: gr.SecretWord,
+		Results: gr.Results,
+		Win: gr.Win,
+	}}
+}
+
+// Returns true if the round is played on more than one board.
+func (gr *GameRound) IsMultiBoard() bool {
+	return len(gr.Boards) > 0
+}
+
+// Get the number of guesses made in the round.
+// Every guess is validated against the boards that are not solved yet, so the longest board has every guess.
+func (gr *GameRound) GetTries() int {
+	tries := len(gr.Results)
+	for _, board := range gr.Boards {
+		if len(board.Results) > tries {
+			tries = len(board.Results)
+		}
+	}
+	return tries
+}
+
+// Get the secret words of the round, joined with a comma for multi-board rounds.
+func (gr *GameRound) GetSecretWord() string {
+	var words []string
+	for _, board := range gr.GetBoards() {
+		words = append(words, board.SecretWord)
+	}
+	return strings.Join(words, ", ")
+}
+
+// Pick a distinct secret word for each board, without replacement from a shuffled copy of the word list.
+// The daily puzzle shuffles the whole word list with a seed made from the date, so every player gets the same boards.
+// Random rounds leave out the filtered words, unless too few of them are left for every board.
+// There are fewer boards than count only if the word list has fewer distinct words, which GameState.ValidateRules rules out.
+func newBoards(count int, wordLength int, dailyDate string) []Board {
+	words := wengine.WordListCache.Words[wordLength]
+	var rng *rand.Rand
+	if dailyDate != "" {
+		h := fnv.New64a()
+		h.Write([]byte(fmt.Sprintf("%s:%s:%d", dailyDate, wengine.WordListCache.Locale.String(), wordLength)))
+		rng = rand.New(rand.NewSource(int64(h.Sum64())))
+	} else {
+		rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+		if filtered := wengine.WordListCache.FilterWordList(words); len(filtered) >= count {
+			words = filtered
+		}
+	}
+	shuffled := make([]string, len(words))
+	copy(shuffled, words)
+	sort.Strings(shuffled)
+	rng.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	boards := make([]Board, 0, count)
+	picked := make(map[string]bool)
+	for _, word := range shuffled {
+		if len(boards) == count {
+			break
+		}
+		if picked[word] {
+			continue
+		}
+		picked[word] = true
+		boards = append(boards, Board{
+			SecretWord: word,
+		})
+	}
+	return boards
+}
+
+// Validates the guess word against every board that is not solved yet.
+// The guess uses a single attempt for all boards. Returns true once every board is solved.
+func (gs *GameState) validateBoards(word string) (bool, error) {
+	round := &gs.SaveState.CurrentGame
+	options := wengine.ValidationOptions{
+		FoldAccents: gs.FoldAccents,
+	}
+
+	results := make([]wengine.ValidationResult, len(round.Boards))
+	for i, board := range round.Boards {
+		if board.Win {
+			continue
+		}
+		result, err := wengine.ValidateWordWithOptions(word, board.SecretWord, options)
+		if err != nil {
+			return false, err
+		}
+		results[i] = result
+	}
+
+	round.RemainingAttempts -= 1
+	solved := true
+	for i := range round.Boards {
+		board := &round.Boards[i]
+		if !board.Win {
+			board.Results = append(board.Results, results[i])
+			board.Win = results[i].Match
+		}
+		solved = solved && board.Win
+	}
+	round.logEvent(RoundEvent{
+		Type: GuessSubmitted,
+		Word: word,
+		Results: results,
+	})
+	return solved, nil
+}
@@ -0,0 +1,171 @@
+package gengine
+
+import (
+	"testing"
+
+	"github.com/tanmancan/gwordle/v1/internal/wengine"
+)
+
+func TestGame_boards(t *testing.T) {
+	tests := []struct {
+		name        string
+		words       []string
+		want        bool
+		wantResults []int
+		wantWins    []bool
+	}{
+		{
+			name:        "Solves one board",
+			words:       []string{"hello"},
+			want:        false,
+			wantResults: []int{1, 1},
+			wantWins:    []bool{true, false},
+		},
+		{
+			name:        "Solved boards are skipped",
+			words:       []string{"hello", "stare", "poise"},
+			want:        true,
+			wantResults: []int{1, 3},
+			wantWins:    []bool{true, true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game := resumeTestGame(t, Options{MaxTries: 7, Boards: 2}, GameRound{
+				RemainingAttempts: 7,
+				MaxTries:          7,
+				Boards: []Board{
+					{SecretWord: "hello"},
+					{SecretWord: "poise"},
+				},
+			})
+			var result Result
+			for _, word := range tt.words {
+				var err error
+				if result, err = game.Guess(word); err != nil {
+					t.Fatalf("Game.Guess(%q) error = %v", word, err)
+				}
+			}
+			round := result.Round
+			if result.Win != tt.want {
+				t.Errorf("Game.Guess() win = %v, want %v", result.Win, tt.want)
+			}
+			if round.RemainingAttempts != 7-len(tt.words) {
+				t.Errorf("RemainingAttempts = %v, want %v", round.RemainingAttempts, 7-len(tt.words))
+			}
+			if round.GetTries() != len(tt.words) {
+				t.Errorf("GameRound.GetTries() = %v, want %v", round.GetTries(), len(tt.words))
+			}
+			for i, board := range round.Boards {
+				if len(board.Results) != tt.wantResults[i] || board.Win != tt.wantWins[i] {
+					t.Errorf("board %d has %d results and win %v, want %d and %v", i, len(board.Results), board.Win, tt.wantResults[i], tt.wantWins[i])
+				}
+			}
+		})
+	}
+}
+
+func TestNewBoards(t *testing.T) {
+	newTestGame(t, "poise", 6)
+	words := []string{"crate", "hello", "poise", "spore", "store", "trace", "sport", "short", "snort", "swill"}
+	tests := []struct {
+		name        string
+		words       []string
+		filterWords []string
+		count       int
+		dailyDate   string
+		wantBoards  int
+	}{
+		{
+			name:        "Random boards leave out the filtered words",
+			words:       words,
+			filterWords: []string{"crate", "hello"},
+			count:       8,
+			wantBoards:  8,
+		},
+		{
+			name:        "Too few words left after filtering",
+			words:       words,
+			filterWords: words[:5],
+			count:       8,
+			wantBoards:  8,
+		},
+		{
+			name:       "Fewer distinct words than boards",
+			words:      []string{"hello", "hello", "poise"},
+			count:      4,
+			wantBoards: 2,
+		},
+		{
+			name:       "Daily boards",
+			words:      words,
+			count:      8,
+			dailyDate:  "2022-04-12",
+			wantBoards: 8,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wengine.WordListCache = wengine.WordList{
+				Words:       map[int][]string{5: tt.words},
+				FilterWords: tt.filterWords,
+			}
+			boards := newBoards(tt.count, 5, tt.dailyDate)
+			if len(boards) != tt.wantBoards {
+				t.Fatalf("newBoards() = %d boards, want %d", len(boards), tt.wantBoards)
+			}
+			picked := make(map[string]bool)
+			for _, board := range boards {
+				if picked[board.SecretWord] {
+					t.Errorf("newBoards() picked %q twice", board.SecretWord)
+				}
+				picked[board.SecretWord] = true
+			}
+			if len(tt.words)-len(tt.filterWords) >= tt.count {
+				for _, word := range tt.filterWords {
+					if picked[word] {
+						t.Errorf("newBoards() picked the filtered word %q", word)
+					}
+				}
+			}
+			if tt.dailyDate == "" {
+				return
+			}
+			again := newBoards(tt.count, 5, tt.dailyDate)
+			for i := range boards {
+				if again[i].SecretWord != boards[i].SecretWord {
+					t.Errorf("newBoards() = %v, want the same daily boards %v on every call", again, boards)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestGameRound_GetSecretWord(t *testing.T) {
+	tests := []struct {
+		name  string
+		round GameRound
+		want  string
+	}{
+		{
+			name:  "Single board",
+			round: GameRound{SecretWord: "hello"},
+			want:  "hello",
+		},
+		{
+			name: "Multiple boards",
+			round: GameRound{
+				Boards: []Board{{SecretWord: "hello"}, {SecretWord: "poise"}},
+			},
+			want: "hello, poise",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.round.GetSecretWord(); got != tt.want {
+				t.Errorf("GameRound.GetSecretWord() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package gengine
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/tanmancan/gwordle/v1/internal/localization"
+	"github.com/tanmancan/gwordle/v1/internal/wengine"
+)
+
+// Returned when a guess word is neither in the word list nor in the dictionary.
+type InvalidWordError struct {
+	Word string
+}
+
+func (e *InvalidWordError) Error() string {
+	return fmt.Sprintf("invalid word: %s", e.Word)
+}
+
+// Returned when a guess word is not in the word list, and the dictionary could not be reached to check it.
+type UnknownWordError struct {
+	Word string
+	Err error // The error returned by the dictionary.
+}
+
+func (e *UnknownWordError) Error() string {
+	return fmt.Sprintf("unknown word: %s: %v", e.Word, e.Err)
+}
+
+// Unwraps the error returned by the dictionary.
+func (e *UnknownWordError) Unwrap() error {
+	return e.Err
+}
+
+// Returned when a guess word does not have the word length of the round.
+type WordLengthError struct {
+	Word string
+	Length int // The word length of the round.
+}
+
+func (e *WordLengthError) Error() string {
+	return fmt.Sprintf("%s does not have %d letters", e.Word, e.Length)
+}
+
+// Returned when the daily puzzle was already played for the date. No round can be played until the next day.
+type DailyCompletedError struct {
+	Date string
+}
+
+func (e *DailyCompletedError) Error() string {
+	return fmt.Sprintf("the daily puzzle for %s was already played", e.Date)
+}
+
+// Get a localized message describing an error returned by NewGame, Game.Guess, Game.Forfeit or Game.Undo.
+// Errors the engine does not know about are described by their own message.
+func ErrorMessage(err error) string {
+	validation := localization.AppTranslatable.Validation
+	rules := localization.AppTranslatable.Rules
+	var (
+		invalidWord *InvalidWordError
+		unknownWord *UnknownWordError
+		wordLength *WordLengthError
+		violation *wengine.ConstraintViolation
+		dailyCompleted *DailyCompletedError
+		notEnoughWords *wengine.NotEnoughWordsError
+	)
+	switch {
+	case errors.As(err, &invalidWord):
+		return fmt.Sprintf(validation.InvalidWord, invalidWord.Word)
+	case errors.As(err, &unknownWord):
+		return fmt.Sprintf(validation.UnknownWord, unknownWord.Word)
+	case errors.As(err, &wordLength):
+		return fmt.Sprintf(validation.WrongLength, wordLength.Word, wordLength.Length)
+	case errors.As(err, &violation):
+		char := strings.ToUpper(violation.Char)
+		if violation.Status == wengine.ValidPosition {
+			return fmt.Sprintf(validation.HardModePosition, char, violation.Index+1)
+		}
+		return fmt.Sprintf(validation.HardModePresent, char)
+	case errors.As(err, &dailyCompleted):
+		return fmt.Sprintf(localization.AppTranslatable.Daily.Completed, dailyCompleted.Date)
+	case errors.As(err, &notEnoughWords):
+		return fmt.Sprintf(rules.NotEnoughWords, notEnoughWords.Length, notEnoughWords.Count, wengine.MinCandidateWords)
+	case errors.Is(err, ErrInvalidMaxTries):
+		return rules.InvalidMaxTries
+	case errors.Is(err, ErrInvalidBoards):
+		return rules.InvalidBoards
+	case errors.Is(err, ErrHardModeBoards):
+		return rules.HardModeBoards
+	case errors.Is(err, ErrAbsurdleBoards):
+		return rules.AbsurdleBoards
+	case errors.Is(err, ErrNothingToUndo):
+		return localization.AppTranslatable.Undo.NothingToUndo
+	case errors.Is(err, ErrUndoDaily):
+		return localization.AppTranslatable.Undo.Daily
+	}
+	return err.Error()
+}
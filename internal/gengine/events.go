@@ -0,0 +1,197 @@
+package gengine
+
+import (
+	"errors"
+	"time"
+
+	"github.com/tanmancan/gwordle/v1/internal/wengine"
+)
+
+// Type of an event in the log of a round.
+type RoundEventType = string
+
+// Types of events in the log of a round.
+const (
+	RoundStarted RoundEventType = "STARTED"
+	GuessSubmitted RoundEventType = "GUESS"
+	HintUsed RoundEventType = "HINT"
+	GuessUndone RoundEventType = "UNDO"
+	RoundForfeited RoundEventType = "FORFEIT"
+	RoundWon RoundEventType = "WIN"
+	RoundLost RoundEventType = "LOSE"
+)
+
+// Returned when the current round has no guess to undo.
+var ErrNothingToUndo = errors.New("There is no guess to undo.")
+
+// Returned when undoing a guess of the daily puzzle, which everyone plays with the same guesses counted.
+var ErrUndoDaily = errors.New("Guesses of the daily puzzle cannot be undone.")
+
+// An event in the log of a round. Events are only ever appended, so the log can be replayed in order.
+// An undone guess stays in the log, followed by an undo event.
+type RoundEvent struct {
+	Type RoundEventType
+	Time time.Time
+	Word string `json:",omitempty"` // The guess word of a guess or undo event.
+	Results []wengine.ValidationResult `json:",omitempty"` // Results of a guess event, by board. Boards solved before the guess have an empty result.
+}
+
+// A step of a replayed round: an event, and the round as it was right after the event.
+type ReplayStep struct {
+	Event RoundEvent
+	Round GameRound
+}
+
+// Append an event to the log of the round.
+func (gr *GameRound) logEvent(event RoundEvent) {
+	event.Time = time.Now()
+	gr.Events = append(gr.Events, event)
+}
+
+// Record that a hint was used in the current round.
+func (g *Game) LogHint() {
+	g.gs.SaveState.CurrentGame.logEvent(RoundEvent{
+		Type: HintUsed,
+	})
+}
+
+// Get the event log of the round.
+// Rounds saved before the log was kept get a log rebuilt from their results, without hints or times.
+func (gr *GameRound) GetEvents() []RoundEvent {
+	if len(gr.Events) > 0 {
+		return gr.Events
+	}
+
+	events := []RoundEvent{{Type: RoundStarted}}
+	boards := gr.GetBoards()
+	for i := 0; i < gr.GetTries(); i++ {
+		event := RoundEvent{
+			Type: GuessSubmitted,
+			Results: make([]wengine.ValidationResult, len(boards)),
+		}
+		for j, board := range boards {
+			if i < len(board.Results) {
+				event.Results[j] = board.Results[i]
+				event.Word = board.Results[i].GuessWord()
+			}
+		}
+		events = append(events, event)
+	}
+	if gr.Win {
+		events = append(events, RoundEvent{Type: RoundWon})
+	} else if gr.RemainingAttempts == 0 {
+		events = append(events, RoundEvent{Type: RoundLost})
+	}
+	return events
+}
+
+// Get the guess events of the log that were not undone, in order.
+func activeGuesses(events []RoundEvent) []RoundEvent {
+	var guesses []RoundEvent
+	for _, event := range events {
+		switch event.Type {
+		case GuessSubmitted:
+			guesses = append(guesses, event)
+		case GuessUndone:
+			if len(guesses) > 0 {
+				guesses = guesses[:len(guesses)-1]
+			}
+		}
+	}
+	return guesses
+}
+
+// Remove the results of the guess event from the boards of the round, and give back its attempt.
+func (gr *GameRound) removeGuess(guess RoundEvent) {
+	for i, result := range guess.Results {
+		if len(result.Chars) == 0 {
+			continue
+		}
+		if gr.IsMultiBoard() {
+			board := &gr.Boards[i]
+			board.Results = board.Results[:len(board.Results)-1]
+			board.Win = false
+			continue
+		}
+		gr.Results = gr.Results[:len(gr.Results)-1]
+	}
+	gr.RemainingAttempts++
+	gr.Win = false
+}
+
+// Take back the last guess of the current round, as if it was never submitted, and record it in the event log.
+// Returns the guess word, ErrNothingToUndo if no guess is left to undo, or ErrUndoDaily for the daily puzzle.
+func (gs *GameState) undo() (string, error) {
+	round := &gs.SaveState.CurrentGame
+	if round.DailyDate != "" {
+		return "", ErrUndoDaily
+	}
+	// Rounds saved before the log was kept get their rebuilt log, so the undo event is not the only one.
+	round.Events = round.GetEvents()
+	guesses := activeGuesses(round.Events)
+	if len(guesses) == 0 {
+		return "", ErrNothingToUndo
+	}
+
+	last := guesses[len(guesses)-1]
+	round.removeGuess(last)
+	if round.Absurdle {
+		gs.rebuildAbsurdleCandidates()
+	}
+	round.logEvent(RoundEvent{
+		Type: GuessUndone,
+		Word: last.Word,
+	})
+	return last.Word, nil
+}
+
+// Replay the round from its event log. Each step has the round as it was right after the event,
+// with the guesses up to that point on its boards.
+func ReplayRound(round GameRound) []ReplayStep {
+	replay := GameRound{
+		SecretWord: round.SecretWord,
+		DailyDate: round.DailyDate,
+		WordLength: round.GetWordLength(),
+		MaxTries: round.GetMaxTries(),
+		RemainingAttempts: round.GetMaxTries(),
+		Absurdle: round.Absurdle,
+	}
+	for _, board := range round.Boards {
+		replay.Boards = append(replay.Boards, Board{
+			SecretWord: board.SecretWord,
+		})
+	}
+
+	var steps []ReplayStep
+	var guesses []RoundEvent
+	for _, event := range round.GetEvents() {
+		switch event.Type {
+		case GuessUndone:
+			if len(guesses) > 0 {
+				replay.removeGuess(guesses[len(guesses)-1])
+				guesses = guesses[:len(guesses)-1]
+			}
+		case GuessSubmitted:
+			guesses = append(guesses, event)
+			replay.RemainingAttempts--
+			for i, result := range event.Results {
+				if len(result.Chars) == 0 {
+					continue
+				}
+				if replay.IsMultiBoard() {
+					replay.Boards[i].Results = append(replay.Boards[i].Results, result)
+					replay.Boards[i].Win = result.Match
+					continue
+				}
+				replay.Results = append(replay.Results, result)
+			}
+		case RoundWon:
+			replay.Win = true
+		}
+		steps = append(steps, ReplayStep{
+			Event: event,
+			Round: replay.clone(),
+		})
+	}
+	return steps
+}
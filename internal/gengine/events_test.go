@@ -0,0 +1,117 @@
+package gengine
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tanmancan/gwordle/v1/internal/wengine"
+)
+
+func TestReplayRound(t *testing.T) {
+	first, _ := wengine.ValidateWord("crate", "sport")
+	second, _ := wengine.ValidateWord("sport", "sport")
+	tests := []struct {
+		name          string
+		round         GameRound
+		wantTypes     []RoundEventType
+		wantTries     []int
+		wantRemaining []int
+	}{
+		{
+			name: "Round with an event log",
+			round: GameRound{
+				SecretWord: "sport",
+				MaxTries:   6,
+				Results:    []wengine.ValidationResult{first, second},
+				Win:        true,
+				Events: []RoundEvent{
+					{Type: RoundStarted},
+					{Type: GuessSubmitted, Word: "crate", Results: []wengine.ValidationResult{first}},
+					{Type: HintUsed},
+					{Type: GuessSubmitted, Word: "sport", Results: []wengine.ValidationResult{second}},
+					{Type: RoundWon},
+				},
+			},
+			wantTypes:     []RoundEventType{RoundStarted, GuessSubmitted, HintUsed, GuessSubmitted, RoundWon},
+			wantTries:     []int{0, 1, 1, 2, 2},
+			wantRemaining: []int{6, 5, 5, 4, 4},
+		},
+		{
+			name: "Round saved before the event log",
+			round: GameRound{
+				SecretWord:        "sport",
+				RemainingAttempts: 0,
+				Results:           []wengine.ValidationResult{first},
+			},
+			wantTypes:     []RoundEventType{RoundStarted, GuessSubmitted, RoundLost},
+			wantTries:     []int{0, 1, 1},
+			wantRemaining: []int{1, 0, 0},
+		},
+		{
+			name: "Multi-board round",
+			round: GameRound{
+				MaxTries: 7,
+				Boards: []Board{
+					{SecretWord: "sport", Results: []wengine.ValidationResult{second}, Win: true},
+					{SecretWord: "crate", Results: []wengine.ValidationResult{first}},
+				},
+				Events: []RoundEvent{
+					{Type: RoundStarted},
+					{Type: GuessSubmitted, Word: "sport", Results: []wengine.ValidationResult{second, first}},
+					{Type: RoundForfeited},
+					{Type: RoundLost},
+				},
+			},
+			wantTypes:     []RoundEventType{RoundStarted, GuessSubmitted, RoundForfeited, RoundLost},
+			wantTries:     []int{0, 1, 1, 1},
+			wantRemaining: []int{7, 6, 6, 6},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			steps := ReplayRound(tt.round)
+			var gotTypes []RoundEventType
+			var gotTries, gotRemaining []int
+			for _, step := range steps {
+				gotTypes = append(gotTypes, step.Event.Type)
+				gotTries = append(gotTries, step.Round.GetTries())
+				gotRemaining = append(gotRemaining, step.Round.RemainingAttempts)
+			}
+			if !reflect.DeepEqual(gotTypes, tt.wantTypes) {
+				t.Errorf("ReplayRound() events = %v, want %v", gotTypes, tt.wantTypes)
+			}
+			if !reflect.DeepEqual(gotTries, tt.wantTries) {
+				t.Errorf("ReplayRound() tries = %v, want %v", gotTries, tt.wantTries)
+			}
+			if !reflect.DeepEqual(gotRemaining, tt.wantRemaining) {
+				t.Errorf("ReplayRound() remaining attempts = %v, want %v", gotRemaining, tt.wantRemaining)
+			}
+			last := steps[len(steps)-1].Round
+			if last.Win != tt.round.Win {
+				t.Errorf("ReplayRound() last step win = %v, want %v", last.Win, tt.round.Win)
+			}
+		})
+	}
+}
+
+func TestGameState_eventLog(t *testing.T) {
+	game := newTestGame(t, "hello", 6)
+	game.Guess("stare")
+	game.LogHint()
+	result, _ := game.Guess("hello")
+
+	var got []RoundEventType
+	for _, event := range result.Round.Events {
+		got = append(got, event.Type)
+	}
+	want := []RoundEventType{GuessSubmitted, HintUsed, GuessSubmitted, RoundWon}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Events = %v, want %v", got, want)
+	}
+	if word := result.Round.Events[2].Word; word != "hello" {
+		t.Errorf("Events[2].Word = %q, want %q", word, "hello")
+	}
+	if events := game.State().SaveState.CurrentGame.Events; len(events) != 1 || events[0].Type != RoundStarted {
+		t.Errorf("Events of the next round = %v, want a single %s event", events, RoundStarted)
+	}
+}
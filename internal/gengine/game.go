@@ -0,0 +1,267 @@
+package gengine
+
+import (
+	"strings"
+	"time"
+
+	"github.com/tanmancan/gwordle/v1/internal/wengine"
+)
+
+// Rules of a new game. Zero values use AppConfig.UserConfig, like the fields of GameState.
+type Options struct {
+	HardMode bool
+	Daily bool
+	FoldAccents bool
+	Absurdle bool
+	WordLength int
+	MaxTries int
+	Boards int
+	KeepSecretWords bool // Let secret words be picked again. Used by servers, since the filter of the word list is shared by every game.
+	SaveState *SaveState // Saved game to resume. Nil starts a new game.
+}
+
+// A game played one step at a time. It never renders, prompts, exits the process or loops,
+// so every front end, from the terminal to the servers, can drive it and decide what to show.
+type Game struct {
+	gs GameState
+	over bool // The daily puzzle was played, and no new round was started.
+}
+
+// Result of a guess, a forfeit or an undo.
+type Result struct {
+	Word string // The guess word, or the word that was undone. Empty for a forfeit.
+	Results []wengine.ValidationResult // Validation result of the guess, by board. Boards solved before the guess have an empty result.
+	Win bool // The guess solved the round.
+	Lose bool // The round was lost, or forfeited.
+	Round GameRound // The round as it was right after the guess. Once a round ends, Game.State has the next one.
+}
+
+// Create a game with the given rules, resuming the saved game if there is one.
+// Returns the errors of GameState.ValidateRules, or a *DailyCompletedError if the daily puzzle was already played today.
+func NewGame(opts Options) (*Game, error) {
+	g := newGame(opts)
+	if err := g.gs.ValidateRules(); err != nil {
+		return nil, err
+	}
+	if err := g.resume(opts.SaveState); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// Resume a saved game without validating its rules again, for games whose rules were validated by NewGame when they were created.
+// The word list can change in between, so a game that was valid when it was created may no longer pass the checks.
+// Returns a *DailyCompletedError if the daily puzzle was already played today.
+func ResumeGame(opts Options) (*Game, error) {
+	g := newGame(opts)
+	if err := g.resume(opts.SaveState); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func newGame(opts Options) *Game {
+	return &Game{
+		gs: GameState{
+			HardMode: opts.HardMode,
+			Daily: opts.Daily,
+			FoldAccents: opts.FoldAccents,
+			Absurdle: opts.Absurdle,
+			WordLength: opts.WordLength,
+			MaxTries: opts.MaxTries,
+			Boards: opts.Boards,
+			KeepSecretWords: opts.KeepSecretWords,
+		},
+	}
+}
+
+// Load the saved game and start a new round if the saved one cannot be resumed.
+// An unfinished random round is set aside while the daily puzzle is played, and an unfinished daily puzzle
+// while random rounds are played. Each is resumed by the next game of its kind.
+func (g *Game) resume(s *SaveState) error {
+	if s != nil {
+		g.gs.SaveState = *s
+	}
+
+	save := &g.gs.SaveState
+	current := save.CurrentGame
+	suspended := save.SuspendedGame
+	if g.gs.Daily {
+		today := DailyDate(time.Now())
+		if save.LastDailyDate == today {
+			return &DailyCompletedError{
+				Date: today,
+			}
+		}
+		if current.DailyDate == today {
+			return nil
+		}
+		if suspended != nil && suspended.DailyDate != today {
+			suspended = nil
+		}
+		g.switchRound(suspended)
+		return nil
+	}
+
+	if s != nil && current.DailyDate == "" && !save.roundEnded() {
+		return nil
+	}
+	if suspended != nil && suspended.DailyDate != "" {
+		suspended = nil
+	}
+	g.switchRound(suspended)
+	return nil
+}
+
+// Set aside the current round if it can still be played, and switch to the given round, or to a new round if it is nil.
+// A round that cannot be played any more, such as the daily puzzle of another day, is dropped.
+func (g *Game) switchRound(next *GameRound) {
+	save := &g.gs.SaveState
+	current := save.CurrentGame
+	today := DailyDate(time.Now())
+	save.SuspendedGame = nil
+	if current.GetTries() > 0 && !save.roundEnded() && (current.DailyDate == "" || current.DailyDate == today) {
+		save.SuspendedGame = &current
+	}
+	if next == nil {
+		g.gs.NewRound()
+		return
+	}
+	save.CurrentGame = *next
+}
+
+// Get a copy of the game state, to render or save it. Changes to the copy do not affect the game.
+func (g *Game) State() GameState {
+	gs := g.gs
+	gs.SaveState.CurrentGame = g.gs.SaveState.CurrentGame.clone()
+	gs.SaveState.PastGames = nil
+	for _, round := range g.gs.SaveState.PastGames {
+		gs.SaveState.PastGames = append(gs.SaveState.PastGames, round.clone())
+	}
+	if suspended := g.gs.SaveState.SuspendedGame; suspended != nil {
+		round := suspended.clone()
+		gs.SaveState.SuspendedGame = &round
+	}
+	return gs
+}
+
+// Returns true once the daily puzzle was played. Guesses and forfeits are rejected from then on.
+func (g *Game) Over() bool {
+	return g.over
+}
+
+// Submit a guess word for the current round. The round ends once the guess solves it, or no attempts remain.
+// Rejected guesses do not use an attempt, and return an *InvalidWordError, *UnknownWordError, *WordLengthError,
+// *wengine.ConstraintViolation in hard mode, or a *DailyCompletedError once the daily puzzle was played.
+func (g *Game) Guess(word string) (Result, error) {
+	if err := g.checkOver(); err != nil {
+		return Result{}, err
+	}
+	word = strings.ToLower(strings.TrimSpace(word))
+	win, err := g.gs.guess(word)
+	if err != nil {
+		return Result{}, err
+	}
+
+	round := g.gs.SaveState.CurrentGame.clone()
+	result := Result{
+		Word: word,
+		Results: round.Events[len(round.Events)-1].Results,
+		Round: round,
+	}
+	switch {
+	case win:
+		result.Win = true
+		result.Round = g.endRound(true)
+	case round.RemainingAttempts == 0:
+		result.Lose = true
+		result.Round = g.endRound(false)
+	}
+	return result, nil
+}
+
+// Submit each guess word in order, as if they were entered one at a time, until the round ends.
+// handle is called with the result or the error of each guess. Rejected words do not use an attempt.
+func (g *Game) GuessWords(words []string, handle func(result Result, err error)) {
+	for _, word := range words {
+		result, err := g.Guess(word)
+		handle(result, err)
+		if result.Win || result.Lose {
+			return
+		}
+	}
+}
+
+// Forfeit the current round. It is recorded as a loss, and a new round is started.
+// Returns a *DailyCompletedError once the daily puzzle was played.
+func (g *Game) Forfeit() (Result, error) {
+	if err := g.checkOver(); err != nil {
+		return Result{}, err
+	}
+	g.gs.SaveState.CurrentGame.logEvent(RoundEvent{
+		Type: RoundForfeited,
+	})
+	return Result{
+		Lose: true,
+		Round: g.endRound(false),
+	}, nil
+}
+
+// Take back the last guess of the current round. Its attempt is given back, and the undo is recorded in the event log.
+// Returns the round after the undo, or ErrNothingToUndo, ErrUndoDaily, or a *DailyCompletedError once the daily puzzle was played.
+func (g *Game) Undo() (Result, error) {
+	if err := g.checkOver(); err != nil {
+		return Result{}, err
+	}
+	word, err := g.gs.undo()
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{
+		Word: word,
+		Round: g.gs.SaveState.CurrentGame.clone(),
+	}, nil
+}
+
+func (g *Game) endRound(win bool) GameRound {
+	round := g.gs.endRound(win)
+	g.over = g.gs.Daily
+	return round.clone()
+}
+
+func (g *Game) checkOver() error {
+	if !g.over {
+		return nil
+	}
+	return &DailyCompletedError{
+		Date: g.gs.SaveState.LastDailyDate,
+	}
+}
+
+// Get a copy of the round that shares no slices with it.
+func (gr GameRound) clone() GameRound {
+	gr.Results = cloneResults(gr.Results)
+	gr.Candidates = append([]string(nil), gr.Candidates...)
+	boards := gr.Boards
+	gr.Boards = nil
+	for _, board := range boards {
+		board.Results = cloneResults(board.Results)
+		gr.Boards = append(gr.Boards, board)
+	}
+	events := gr.Events
+	gr.Events = nil
+	for _, event := range events {
+		event.Results = cloneResults(event.Results)
+		gr.Events = append(gr.Events, event)
+	}
+	return gr
+}
+
+func cloneResults(results []wengine.ValidationResult) []wengine.ValidationResult {
+	var clones []wengine.ValidationResult
+	for _, result := range results {
+		result.Chars = append([]wengine.CharValidationResult(nil), result.Chars...)
+		clones = append(clones, result)
+	}
+	return clones
+}
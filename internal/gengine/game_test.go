@@ -0,0 +1,722 @@
+package gengine
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tanmancan/gwordle/v1/internal/dictionaryapi"
+	"github.com/tanmancan/gwordle/v1/internal/wengine"
+)
+
+// Create a game resuming a round with the given secret word, without a dictionary.
+func newTestGame(t *testing.T, secretWord string, remainingAttempts int) *Game {
+	t.Helper()
+	return resumeTestGame(t, Options{}, GameRound{
+		SecretWord:        secretWord,
+		RemainingAttempts: remainingAttempts,
+		MaxTries:          6,
+	})
+}
+
+// Create a game with the options resuming the round, without a dictionary, so only words of the word list are accepted.
+// The word length, tries and boards default to 5, 6 and 1. The dictionary provider and the word list
+// are restored once the test ends, since ending a round filters its secret word.
+func resumeTestGame(t *testing.T, opts Options, round GameRound) *Game {
+	t.Helper()
+	prevProvider := dictionaryapi.ActiveProvider
+	prevWords := wengine.WordListCache
+	dictionaryapi.ActiveProvider = dictionaryapi.NoneProvider{}
+	t.Cleanup(func() {
+		dictionaryapi.ActiveProvider = prevProvider
+		wengine.WordListCache = prevWords
+	})
+
+	if opts.WordLength == 0 {
+		opts.WordLength = 5
+	}
+	if opts.MaxTries == 0 {
+		opts.MaxTries = 6
+	}
+	if opts.Boards == 0 {
+		opts.Boards = 1
+	}
+	round.WordLength = opts.WordLength
+	opts.SaveState = &SaveState{
+		CurrentGame: round,
+	}
+	game, err := NewGame(opts)
+	if err != nil {
+		t.Fatalf("NewGame() error = %v", err)
+	}
+	return game
+}
+
+func TestGame_GuessWords(t *testing.T) {
+	tests := []struct {
+		name              string
+		words             []string
+		remainingAttempts int
+		wantWin           bool
+		wantLose          bool
+		wantGuesses       []string
+		wantErrors        int
+	}{
+		{
+			name:              "Single word",
+			words:             []string{"stare"},
+			remainingAttempts: 6,
+			wantGuesses:       []string{"stare"},
+		},
+		{
+			name:              "Words are guessed in order",
+			words:             []string{"stare", "hello"},
+			remainingAttempts: 6,
+			wantGuesses:       []string{"stare", "hello"},
+		},
+		{
+			name:              "Stops at a win",
+			words:             []string{"stare", "poise", "hello"},
+			remainingAttempts: 6,
+			wantWin:           true,
+			wantGuesses:       []string{"stare", "poise"},
+		},
+		{
+			name:              "Stops when attempts run out",
+			words:             []string{"stare", "hello", "poise"},
+			remainingAttempts: 2,
+			wantLose:          true,
+			wantGuesses:       []string{"stare", "hello"},
+		},
+		{
+			name:              "Invalid words are reported without using an attempt",
+			words:             []string{"zzzzz", "stare", "qqqqq", "poise"},
+			remainingAttempts: 2,
+			wantWin:           true,
+			wantGuesses:       []string{"stare", "poise"},
+			wantErrors:        2,
+		},
+		{
+			name:              "No words",
+			words:             nil,
+			remainingAttempts: 6,
+			wantGuesses:       nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game := newTestGame(t, "poise", tt.remainingAttempts)
+
+			var gotGuesses []string
+			var gotWin, gotLose bool
+			gotErrors := 0
+			game.GuessWords(tt.words, func(result Result, err error) {
+				if err != nil {
+					gotErrors++
+					return
+				}
+				gotGuesses = append(gotGuesses, result.Word)
+				gotWin = gotWin || result.Win
+				gotLose = gotLose || result.Lose
+			})
+			if gotWin != tt.wantWin || gotLose != tt.wantLose {
+				t.Errorf("Game.GuessWords() win = %v, lose = %v, want %v and %v", gotWin, gotLose, tt.wantWin, tt.wantLose)
+			}
+			if !reflect.DeepEqual(gotGuesses, tt.wantGuesses) {
+				t.Errorf("Game.GuessWords() guesses = %v, want %v", gotGuesses, tt.wantGuesses)
+			}
+			if gotErrors != tt.wantErrors {
+				t.Errorf("Game.GuessWords() errors = %d, want %d", gotErrors, tt.wantErrors)
+			}
+		})
+	}
+}
+
+func TestGame_Guess(t *testing.T) {
+	tests := []struct {
+		name    string
+		word    string
+		wantErr interface{}
+	}{
+		{
+			name: "Valid word",
+			word: " STARE ",
+		},
+		{
+			name:    "Invalid word",
+			word:    "zzzzz",
+			wantErr: &InvalidWordError{},
+		},
+		{
+			name:    "Word of another length",
+			word:    "star",
+			wantErr: &WordLengthError{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game := newTestGame(t, "poise", 6)
+			result, err := game.Guess(tt.word)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("Game.Guess() error = %v", err)
+				}
+				if result.Word != "stare" || len(result.Results) != 1 || result.Round.RemainingAttempts != 5 {
+					t.Errorf("Game.Guess() = %+v, want one result for stare with 5 attempts left", result)
+				}
+				return
+			}
+			target := reflect.New(reflect.TypeOf(tt.wantErr)).Interface()
+			if !errors.As(err, target) {
+				t.Errorf("Game.Guess() error = %v, want %T", err, tt.wantErr)
+			}
+			if ErrorMessage(err) == "" {
+				t.Errorf("ErrorMessage(%v) is empty", err)
+			}
+			if got := game.State().SaveState.CurrentGame.RemainingAttempts; got != 6 {
+				t.Errorf("RemainingAttempts = %d, want 6", got)
+			}
+		})
+	}
+}
+
+func TestGame_hardMode(t *testing.T) {
+	tests := []struct {
+		name        string
+		word        string
+		wantChar    string
+		wantStatus  wengine.CharValidationStatus
+		wantMessage []string
+	}{
+		{
+			name:        "Found letter moved out of its position",
+			word:        "hello",
+			wantChar:    "e",
+			wantStatus:  wengine.ValidPosition,
+			wantMessage: []string{"E", "5"},
+		},
+		{
+			name:        "Present letter left out",
+			word:        "trace",
+			wantChar:    "s",
+			wantStatus:  wengine.InvalidPosition,
+			wantMessage: []string{"S"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game := resumeTestGame(t, Options{HardMode: true}, GameRound{
+				SecretWord:        "poise",
+				RemainingAttempts: 6,
+				MaxTries:          6,
+			})
+			// Reveals the S, and the E in the last position.
+			if _, err := game.Guess("stare"); err != nil {
+				t.Fatalf("Game.Guess(%q) error = %v", "stare", err)
+			}
+
+			_, err := game.Guess(tt.word)
+			var violation *wengine.ConstraintViolation
+			if !errors.As(err, &violation) {
+				t.Fatalf("Game.Guess(%q) error = %v, want a *wengine.ConstraintViolation", tt.word, err)
+			}
+			if violation.Char != tt.wantChar || violation.Status != tt.wantStatus {
+				t.Errorf("Game.Guess(%q) violation = %+v, want %s with status %v", tt.word, violation, tt.wantChar, tt.wantStatus)
+			}
+			round := game.State().SaveState.CurrentGame
+			if round.RemainingAttempts != 5 || len(round.Results) != 1 {
+				t.Errorf("RemainingAttempts = %d with %d results, want the rejected guess to use no attempt", round.RemainingAttempts, len(round.Results))
+			}
+			message := ErrorMessage(err)
+			for _, want := range tt.wantMessage {
+				if !strings.Contains(message, want) {
+					t.Errorf("ErrorMessage() = %q, want it to contain %q", message, want)
+				}
+			}
+		})
+	}
+}
+
+func TestGame_hardModeRound(t *testing.T) {
+	tests := []struct {
+		name         string
+		hardModes    []bool
+		wantHardMode bool
+	}{
+		{
+			name:         "Every guess in hard mode",
+			hardModes:    []bool{true, true},
+			wantHardMode: true,
+		},
+		{
+			name:         "Hard mode turned off during the round",
+			hardModes:    []bool{true, false},
+			wantHardMode: false,
+		},
+		{
+			name:         "Hard mode turned on during the round",
+			hardModes:    []bool{false, true},
+			wantHardMode: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newTestGame(t, "poise", 6)
+			var save *SaveState
+			var game *Game
+			// Each guess is made by a game resumed with its own hard mode option, as if the game was restarted in between.
+			for i, hardMode := range tt.hardModes {
+				var err error
+				game, err = NewGame(Options{
+					HardMode:   hardMode,
+					WordLength: 5,
+					MaxTries:   6,
+					Boards:     1,
+					SaveState:  save,
+				})
+				if err != nil {
+					t.Fatalf("NewGame() error = %v", err)
+				}
+				if i == 0 {
+					game.gs.SaveState.CurrentGame.SecretWord = "poise"
+				}
+				if _, err := game.Guess([]string{"stare", "spine"}[i]); err != nil {
+					t.Fatalf("Game.Guess() error = %v", err)
+				}
+				state := game.State().SaveState
+				save = &state
+			}
+
+			result, err := game.Forfeit()
+			if err != nil {
+				t.Fatalf("Game.Forfeit() error = %v", err)
+			}
+			if result.Round.HardMode != tt.wantHardMode {
+				t.Errorf("GameRound.HardMode = %v, want %v", result.Round.HardMode, tt.wantHardMode)
+			}
+			past := game.State().SaveState.PastGames
+			if got := strings.Contains(ShareText(past[len(past)-1]), "*"); got != tt.wantHardMode {
+				t.Errorf("ShareText() of the past round has the hard mode marker = %v, want %v", got, tt.wantHardMode)
+			}
+		})
+	}
+}
+
+func TestGame_Forfeit(t *testing.T) {
+	game := newTestGame(t, "poise", 6)
+	game.Guess("stare")
+	result, err := game.Forfeit()
+	if err != nil {
+		t.Fatalf("Game.Forfeit() error = %v", err)
+	}
+	if !result.Lose || result.Round.SecretWord != "poise" || result.Round.GetTries() != 1 {
+		t.Errorf("Game.Forfeit() = %+v, want a loss of the round with 1 try", result)
+	}
+
+	state := game.State()
+	if len(state.SaveState.PastGames) != 1 {
+		t.Errorf("PastGames = %d rounds, want 1", len(state.SaveState.PastGames))
+	}
+	if state.SaveState.CurrentGame.GetTries() != 0 || state.SaveState.CurrentGame.RemainingAttempts != 6 {
+		t.Errorf("CurrentGame = %+v, want a new round", state.SaveState.CurrentGame)
+	}
+}
+
+func TestGame_daily(t *testing.T) {
+	game := newTestGame(t, "poise", 6)
+	game.gs.Daily = true
+	game.gs.SaveState.CurrentGame.DailyDate = "2022-04-12"
+
+	if _, err := game.Guess("poise"); err != nil {
+		t.Fatalf("Game.Guess() error = %v", err)
+	}
+	if !game.Over() {
+		t.Errorf("Game.Over() = false after the daily puzzle")
+	}
+	var completed *DailyCompletedError
+	if _, err := game.Guess("stare"); !errors.As(err, &completed) || completed.Date != "2022-04-12" {
+		t.Errorf("Game.Guess() error = %v, want a *DailyCompletedError for 2022-04-12", err)
+	}
+	if _, err := game.Forfeit(); !errors.As(err, &completed) {
+		t.Errorf("Game.Forfeit() error = %v, want a *DailyCompletedError", err)
+	}
+}
+
+func TestGame_KeepSecretWords(t *testing.T) {
+	tests := []struct {
+		name            string
+		keepSecretWords bool
+		wantFiltered    bool
+	}{
+		{
+			name:         "Secret words are filtered",
+			wantFiltered: true,
+		},
+		{
+			name:            "Secret words are kept",
+			keepSecretWords: true,
+			wantFiltered:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game := newTestGame(t, "poise", 6)
+			game.gs.KeepSecretWords = tt.keepSecretWords
+			wengine.WordListCache.FilterWords = nil
+			if _, err := game.Forfeit(); err != nil {
+				t.Fatalf("Game.Forfeit() error = %v", err)
+			}
+			if got := wengine.WordListCache.HasFilterWord("poise"); got != tt.wantFiltered {
+				t.Errorf("HasFilterWord(poise) = %v, want %v", got, tt.wantFiltered)
+			}
+		})
+	}
+}
+
+func TestResumeGame(t *testing.T) {
+	newTestGame(t, "poise", 6)
+	save := &SaveState{
+		CurrentGame: GameRound{
+			SecretWord:        "poise",
+			RemainingAttempts: 6,
+			WordLength:        5,
+			MaxTries:          6,
+		},
+	}
+	opts := Options{
+		WordLength: 5,
+		MaxTries:   6,
+		Boards:     1,
+		SaveState:  save,
+	}
+	// Leave fewer words than needed to start a game, as if every other word was played.
+	words := wengine.WordListCache.Words[5]
+	wengine.WordListCache.FilterWords = append([]string(nil), words[:len(words)-1]...)
+	sort.Strings(wengine.WordListCache.FilterWords)
+
+	var notEnoughWords *wengine.NotEnoughWordsError
+	if _, err := NewGame(opts); !errors.As(err, &notEnoughWords) {
+		t.Errorf("NewGame() error = %v, want a *wengine.NotEnoughWordsError", err)
+	}
+	game, err := ResumeGame(opts)
+	if err != nil {
+		t.Fatalf("ResumeGame() error = %v", err)
+	}
+	if got := game.State().SaveState.CurrentGame.SecretWord; got != "poise" {
+		t.Errorf("ResumeGame() resumed %q, want poise", got)
+	}
+}
+
+func TestNewGame_resume(t *testing.T) {
+	newTestGame(t, "poise", 6)
+	stare, _ := wengine.ValidateWord("stare", "poise")
+	randomRound := GameRound{
+		SecretWord:        "poise",
+		RemainingAttempts: 5,
+		WordLength:        5,
+		MaxTries:          6,
+		Results:           []wengine.ValidationResult{stare},
+	}
+	forfeitedDaily := randomRound
+	forfeitedDaily.DailyDate = "2022-04-12"
+	unfinishedDaily := forfeitedDaily
+	unfinishedDaily.DailyDate = DailyDate(time.Now())
+
+	tests := []struct {
+		name          string
+		save          SaveState
+		wantResume    bool
+		wantSuspended bool
+	}{
+		{
+			name:       "Unfinished random round is resumed",
+			save:       SaveState{CurrentGame: randomRound},
+			wantResume: true,
+		},
+		{
+			name:          "Unfinished daily round is set aside",
+			save:          SaveState{CurrentGame: unfinishedDaily},
+			wantResume:    false,
+			wantSuspended: true,
+		},
+		{
+			name: "Suspended random round is resumed instead of an unfinished daily round",
+			save: SaveState{
+				CurrentGame:   unfinishedDaily,
+				SuspendedGame: &randomRound,
+			},
+			wantResume:    true,
+			wantSuspended: true,
+		},
+		{
+			name: "Forfeited daily round is not resumed",
+			save: SaveState{
+				CurrentGame:   forfeitedDaily,
+				PastGames:     []GameRound{forfeitedDaily},
+				LastDailyDate: forfeitedDaily.DailyDate,
+			},
+			wantResume: false,
+		},
+		{
+			name: "Suspended random round is resumed after the daily puzzle",
+			save: SaveState{
+				CurrentGame:   forfeitedDaily,
+				PastGames:     []GameRound{forfeitedDaily},
+				LastDailyDate: forfeitedDaily.DailyDate,
+				SuspendedGame: &randomRound,
+			},
+			wantResume: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			save := tt.save
+			game, err := NewGame(Options{
+				WordLength: 5,
+				MaxTries:   6,
+				Boards:     1,
+				SaveState:  &save,
+			})
+			if err != nil {
+				t.Fatalf("NewGame() error = %v", err)
+			}
+			state := game.State().SaveState
+			gotResume := state.CurrentGame.GetTries() == 1
+			if gotResume != tt.wantResume {
+				t.Errorf("NewGame() resumed the saved round = %v, want %v", gotResume, tt.wantResume)
+			}
+			if gotSuspended := state.SuspendedGame != nil; gotSuspended != tt.wantSuspended {
+				t.Errorf("SuspendedGame = %+v, want a suspended round %v", state.SuspendedGame, tt.wantSuspended)
+			}
+			if tt.wantSuspended && state.SuspendedGame.DailyDate != unfinishedDaily.DailyDate {
+				t.Errorf("SuspendedGame.DailyDate = %q, want the unfinished daily round", state.SuspendedGame.DailyDate)
+			}
+			if len(state.PastGames) != len(tt.save.PastGames) {
+				t.Errorf("PastGames = %d rounds, want %d", len(state.PastGames), len(tt.save.PastGames))
+			}
+		})
+	}
+}
+
+func TestNewGame_suspendForDaily(t *testing.T) {
+	newTestGame(t, "poise", 6)
+	stare, _ := wengine.ValidateWord("stare", "poise")
+	save := SaveState{
+		CurrentGame: GameRound{
+			SecretWord:        "poise",
+			RemainingAttempts: 5,
+			WordLength:        5,
+			MaxTries:          6,
+			Results:           []wengine.ValidationResult{stare},
+		},
+	}
+	opts := Options{
+		Daily:      true,
+		WordLength: 5,
+		MaxTries:   6,
+		Boards:     1,
+		SaveState:  &save,
+	}
+	game, err := NewGame(opts)
+	if err != nil {
+		t.Fatalf("NewGame() error = %v", err)
+	}
+	if got := game.State().SaveState.CurrentGame.DailyDate; got != DailyDate(time.Now()) {
+		t.Errorf("CurrentGame.DailyDate = %q, want today", got)
+	}
+	if _, err := game.Forfeit(); err != nil {
+		t.Fatalf("Game.Forfeit() error = %v", err)
+	}
+
+	// The forfeited daily round can neither be played again today, nor resumed as a random round.
+	save = game.State().SaveState
+	var completed *DailyCompletedError
+	if _, err := NewGame(opts); !errors.As(err, &completed) {
+		t.Errorf("NewGame() error = %v, want a *DailyCompletedError", err)
+	}
+	opts.Daily = false
+	game, err = NewGame(opts)
+	if err != nil {
+		t.Fatalf("NewGame() error = %v", err)
+	}
+	state := game.State().SaveState
+	if state.CurrentGame.SecretWord != "poise" || state.CurrentGame.GetTries() != 1 {
+		t.Errorf("CurrentGame = %+v, want the suspended random round", state.CurrentGame)
+	}
+	if _, err := game.Guess("poise"); err != nil {
+		t.Fatalf("Game.Guess() error = %v", err)
+	}
+	if got := len(game.State().SaveState.PastGames); got != 2 {
+		t.Errorf("PastGames = %d rounds, want 2", got)
+	}
+}
+
+func TestNewGame_randomDailyRandom(t *testing.T) {
+	newTestGame(t, "poise", 6)
+	save := SaveState{
+		CurrentGame: GameRound{
+			SecretWord:        "poise",
+			RemainingAttempts: 6,
+			WordLength:        5,
+			MaxTries:          6,
+		},
+	}
+	// Guess a word that does not solve the daily puzzle, whatever today's word is.
+	dailyGuess := "trace"
+	if wengine.WordListCache.GetDailyWord(5, DailyDate(time.Now()), wengine.WordListCache.Locale) == dailyGuess {
+		dailyGuess = "stare"
+	}
+	play := func(daily bool, word string) GameState {
+		t.Helper()
+		game, err := NewGame(Options{
+			Daily:      daily,
+			WordLength: 5,
+			MaxTries:   6,
+			Boards:     1,
+			SaveState:  &save,
+		})
+		if err != nil {
+			t.Fatalf("NewGame() error = %v", err)
+		}
+		if word != "" {
+			if _, err := game.Guess(word); err != nil {
+				t.Fatalf("Game.Guess(%q) error = %v", word, err)
+			}
+		}
+		save = game.State().SaveState
+		return game.State()
+	}
+
+	random := play(false, "stare").SaveState.CurrentGame
+	daily := play(true, dailyGuess).SaveState.CurrentGame
+	steps := []struct {
+		name          string
+		daily         bool
+		wantCurrent   GameRound
+		wantSuspended GameRound
+	}{
+		{
+			name:          "Random round is resumed and the daily round is set aside",
+			wantCurrent:   random,
+			wantSuspended: daily,
+		},
+		{
+			name:          "Daily round is resumed and the random round is set aside",
+			daily:         true,
+			wantCurrent:   daily,
+			wantSuspended: random,
+		},
+	}
+	for _, step := range steps {
+		state := play(step.daily, "").SaveState
+		if !reflect.DeepEqual(state.CurrentGame, step.wantCurrent) {
+			t.Errorf("%s: CurrentGame = %+v, want %+v", step.name, state.CurrentGame, step.wantCurrent)
+		}
+		if state.SuspendedGame == nil || !reflect.DeepEqual(*state.SuspendedGame, step.wantSuspended) {
+			t.Errorf("%s: SuspendedGame = %+v, want %+v", step.name, state.SuspendedGame, step.wantSuspended)
+		}
+	}
+
+	// Once the daily round ends, the random round is resumed and nothing is left aside.
+	play(true, daily.SecretWord)
+	state := play(false, "").SaveState
+	if !reflect.DeepEqual(state.CurrentGame, random) || state.SuspendedGame != nil {
+		t.Errorf("CurrentGame = %+v, SuspendedGame = %+v, want the random round and nothing set aside", state.CurrentGame, state.SuspendedGame)
+	}
+}
+
+func TestGame_State(t *testing.T) {
+	game := newTestGame(t, "poise", 6)
+	if _, err := game.Guess("stare"); err != nil {
+		t.Fatalf("Game.Guess() error = %v", err)
+	}
+
+	state := game.State()
+	round := &state.SaveState.CurrentGame
+	round.Results[0].Chars[0].Char = "x"
+	round.Results = append(round.Results[:0], wengine.ValidationResult{})
+	round.Events[0].Type = RoundWon
+
+	got := game.State().SaveState.CurrentGame
+	if got.Results[0].Chars[0].Char != "s" || len(got.Results[0].Chars) != 5 {
+		t.Errorf("CurrentGame.Results = %+v, changed through a copy", got.Results)
+	}
+	if got.Events[0].Type != GuessSubmitted {
+		t.Errorf("CurrentGame.Events[0].Type = %v, changed through a copy", got.Events[0].Type)
+	}
+}
+
+func TestGame_Undo(t *testing.T) {
+	tests := []struct {
+		name          string
+		guesses       []string
+		daily         bool
+		wantErr       error
+		wantWord      string
+		wantResults   []string
+		wantRemaining int
+	}{
+		{
+			name:          "Takes back the last guess",
+			guesses:       []string{"stare", "hello"},
+			wantWord:      "hello",
+			wantResults:   []string{"stare"},
+			wantRemaining: 5,
+		},
+		{
+			name:    "Nothing to undo",
+			wantErr: ErrNothingToUndo,
+		},
+		{
+			name:    "Daily puzzle",
+			guesses: []string{"stare"},
+			daily:   true,
+			wantErr: ErrUndoDaily,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game := newTestGame(t, "poise", 6)
+			if tt.daily {
+				game.gs.Daily = true
+				game.gs.SaveState.CurrentGame.DailyDate = "2022-04-12"
+			}
+			for _, word := range tt.guesses {
+				if _, err := game.Guess(word); err != nil {
+					t.Fatalf("Game.Guess(%q) error = %v", word, err)
+				}
+			}
+
+			result, err := game.Undo()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Game.Undo() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if result.Word != tt.wantWord {
+				t.Errorf("Game.Undo() word = %q, want %q", result.Word, tt.wantWord)
+			}
+			var got []string
+			for _, r := range result.Round.Results {
+				got = append(got, r.GuessWord())
+			}
+			if !reflect.DeepEqual(got, tt.wantResults) || result.Round.RemainingAttempts != tt.wantRemaining {
+				t.Errorf("Game.Undo() results = %v, remaining attempts = %d, want %v and %d", got, result.Round.RemainingAttempts, tt.wantResults, tt.wantRemaining)
+			}
+			if last := result.Round.Events[len(result.Round.Events)-1]; last.Type != GuessUndone || last.Word != tt.wantWord {
+				t.Errorf("last event = %+v, want a %s event for %q", last, GuessUndone, tt.wantWord)
+			}
+
+			// The undone guess must not come back once the round is replayed.
+			steps := ReplayRound(result.Round)
+			replay := steps[len(steps)-1].Round
+			if !reflect.DeepEqual(replay.Results, result.Round.Results) || replay.RemainingAttempts != tt.wantRemaining {
+				t.Errorf("ReplayRound() last step = %+v, want the results after the undo", replay)
+			}
+		})
+	}
+}
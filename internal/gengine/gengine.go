@@ -1,32 +1,20 @@
 package gengine
 
 import (
-	"os"
+	"errors"
+	"strings"
+	"time"
 
-	"github.com/tanmancan/gwordle/v1/internal/config"
 	"github.com/tanmancan/gwordle/v1/internal/dictionaryapi"
-	"github.com/tanmancan/gwordle/v1/internal/localization"
 	"github.com/tanmancan/gwordle/v1/internal/wengine"
 )
 
-// Used for user interaction and dialog.
-type UserPrompt interface {
-	// Get user input for a guess word or a help command.
-	GetUserInput(gs *GameState) string
-	// Displays help text.
-	DisplayHelpText(gs *GameState)
-	// Display a message when user loses a round.
-	LoseRoundMessage(gs *GameState)
-	// Display a message when a user wins a round.
-	WinRoundMessage(gs *GameState)
-	// Display a message when a user exists the game.
-	ExitGameMessage(gs *GameState)
-}
-
 // Used to display messages regarding the game state.
 type Renderer interface {
 	// Renders the result of the word validation for the current round.
 	RenderValidationResults(gs *GameState)
+	// Renders the boards of a multi-board round side by side.
+	RenderBoards(gs *GameState)
 	// Renders the current game score.
 	RenderGameScore(gs *GameState)
 	// Renders text inline,with string formatting.
@@ -45,18 +33,32 @@ type MemoryCard interface {
 
 // A single game round.
 type GameRound struct {
-	RemainingAttempts int // Number of attemp remaining. Initial value is determined by AppConfig.UserConfig.MaxTries.
+	RemainingAttempts int // Number of attemp remaining. Initial value is determined by MaxTries.
 	Results []wengine.ValidationResult // Validation result for each guess word.
 	SecretWord string // Current secret word.
 	Win bool // If the current round was won.
+	DailyDate string // Date of the daily puzzle played in this round. Empty for random rounds.
+	WordLength int // Length of the secret word. Saved with the round so it resumes with its original rules.
+	MaxTries int // Maximum number of tries. Saved with the round so it resumes with its original rules.
+	Boards []Board // Boards of a multi-board round. Empty for a single board, which uses SecretWord and Results instead.
+	Absurdle bool // Adversarial round. The secret word is not fixed, and changes after each guess to one of the candidates.
+	HardMode bool // Every guess of the round was made in hard mode. Set when the round is created, and cleared by a guess made without hard mode.
+	Candidates []string // Words that still match every guess of an adversarial round. Cleared once the round ends.
+	Events []RoundEvent // Append-only log of the round, used to replay it.
 }
 
-// The game state.
+// The game state. Played one step at a time through Game, and passed to a Renderer to display it.
 type GameState struct {
+	HardMode bool // Reject guesses that do not use the hints revealed by previous guesses.
+	Daily bool // Play the daily puzzle instead of random rounds.
+	FoldAccents bool // Treat accented characters as their base character when validating guesses.
+	WordLength int // Word length for new rounds. Zero uses AppConfig.UserConfig.WordLength.
+	MaxTries int // Maximum number of tries for new rounds. Zero uses AppConfig.UserConfig.MaxTries.
+	Boards int // Number of boards for new rounds, each with its own secret word. Zero uses AppConfig.UserConfig.Boards.
+	Absurdle bool // Play adversarial rounds, where the secret word is not picked until the guesses leave a single candidate.
+	KeepSecretWords bool // Let the secret words of ended rounds be picked again, instead of adding them to the filter of the shared word list.
 	SaveState SaveState
-	UserPrompt UserPrompt
-	Renderer Renderer
-	MemoryCard MemoryCard
+	Renderer Renderer // Used by front ends to render the state. Never called by the engine.
 }
 
 // Gamestate that can be saved and loaded
@@ -65,6 +67,32 @@ type SaveState struct {
 	CurrentGame GameRound
 	// Past rounds
 	PastGames []GameRound
+	// Date of the last daily puzzle that was completed
+	LastDailyDate string
+	// Unfinished random round set aside while the daily puzzle is played, or unfinished daily puzzle set aside while random rounds are played
+	SuspendedGame *GameRound `json:",omitempty"`
+}
+
+// Returns true if the current round has ended and was recorded in the past rounds.
+// The daily puzzle does not start a new round once it ends, so a forfeited daily round is only known to have ended by its date.
+func (s *SaveState) roundEnded() bool {
+	round := s.CurrentGame
+	return round.Win || round.RemainingAttempts == 0 || (round.DailyDate != "" && round.DailyDate == s.LastDailyDate)
+}
+
+// Get the date used to select the daily puzzle.
+func DailyDate(t time.Time) string {
+	return t.Format("2006-01-02")
+}
+
+// Get the best known status of each letter guessed in the round, so renderers can show which letters are left.
+// In a multi-board round, a letter has the best status it has on any board.
+func (gr *GameRound) GetLetterStatuses() wengine.LetterStatuses {
+	var results []wengine.ValidationResult
+	for _, board := range gr.GetBoards() {
+		results = append(results, board.Results...)
+	}
+	return wengine.GetLetterStatuses(results)
 }
 
 // Get the total number of wins and losses
@@ -80,100 +108,146 @@ func (gs *GameState) GetTotalWinLossCount() (win int, loss int) {
 	return win, loss
 }
 
-// Initialize and begins the game loop
-func (gs *GameState) InitGame(up UserPrompt, r Renderer, mc MemoryCard) {
-	gs.UserPrompt = up
-	gs.Renderer = r
-	gs.MemoryCard = mc
-	prev := mc.LoadGame()
-	if prev != nil {
-		gs.SaveState = *prev
-	} else {
-		gs.NewRound()
-	}
-	gs.GameLoop()
-}
-
-// Main game loop that validates a users input and decides the outcome
-func (gs *GameState) GameLoop() {
-	completed := false
-	for !completed {
-		gs.Renderer.RenderValidationResults(gs)
-		if gs.SaveState.CurrentGame.RemainingAttempts == 0 {
-			completed = true
-			gs.LoseRound()
-		}
-		guess := gs.UserPrompt.GetUserInput(gs)
-		completed = gs.ValidateGuessWord(guess)
+// Renders the current round: its validation results, or its boards side by side in a multi-board round.
+func (gs *GameState) RenderRound() {
+	if gs.SaveState.CurrentGame.IsMultiBoard() {
+		gs.Renderer.RenderBoards(gs)
+		return
 	}
 	gs.Renderer.RenderValidationResults(gs)
-	gs.WinRound()
-	gs.GameLoop()
 }
 
-// Exit the game
-func (gs *GameState) ExitGame() {
-	gs.MemoryCard.SaveGame(&gs.SaveState)
-	gs.UserPrompt.ExitGameMessage(gs)
-	os.Exit(0)
-}
-
-// Validates the guess word
-func (gs *GameState) ValidateGuessWord(word string) bool {
-	if !wengine.WordListCache.HasWord(word) {
-		request := dictionaryapi.GetWordDefinitionRequest{
+// Checks the guess word and validates it against the current round.
+// Returns true if the guess solved the round. Rejected guesses do not use an attempt, and return one of the errors of Game.Guess.
+func (gs *GameState) guess(word string) (bool, error) {
+	round := &gs.SaveState.CurrentGame
+	if wengine.WordLength(word) != round.GetWordLength() {
+		return false, &WordLengthError{
 			Word: word,
+			Length: round.GetWordLength(),
 		}
-		response := dictionaryapi.GetWordDefinition(request)
-		if response.Response != nil && response.Response[0].Word == word {
+	}
+
+	inWordList := wengine.WordListCache.HasWord(word) || (gs.FoldAccents && wengine.WordListCache.HasFoldedWord(word))
+	if !inWordList {
+		definition, found, err := dictionaryapi.Lookup(word)
+		switch {
+		case found && strings.EqualFold(definition.Word, word):
 			missingPath := "internal/cli/static/missing"
 			wengine.WordListFileWriter(missingPath, word)
-		} else {
-			gs.Renderer.RenderTextLn(localization.AppTranslatable.Validation.InvalidWord, word)
-			return false
+		case err != nil && !errors.Is(err, dictionaryapi.ErrNoDictionary):
+			return false, &UnknownWordError{
+				Word: word,
+				Err: err,
+			}
+		default:
+			return false, &InvalidWordError{
+				Word: word,
+			}
 		}
 	}
 
-	result, err := wengine.ValidateWord(word, gs.SaveState.CurrentGame.SecretWord)
+	if gs.HardMode && len(round.Results) > 0 {
+		constraints := wengine.GenerateHintConstraints(round.Results)
+		constraints.FoldAccents = gs.FoldAccents
+		if violation := constraints.CheckWord(word); violation != nil {
+			return false, violation
+		}
+	}
 
-	if err != nil {
-		gs.Renderer.RenderTextLn("%v", err)
-		return false
+	if !gs.HardMode {
+		round.HardMode = false
 	}
 
-	gs.SaveState.CurrentGame.RemainingAttempts -= 1
-	gs.SaveState.CurrentGame.Results = append(gs.SaveState.CurrentGame.Results, result)
+	if round.IsMultiBoard() {
+		return gs.validateBoards(word)
+	}
 
-	if result.Match == false {
-		return false
-	} else {
-		return true
+	if round.Absurdle {
+		return gs.validateAbsurdle(word)
 	}
+
+	result, err := wengine.ValidateWordWithOptions(word, round.SecretWord, wengine.ValidationOptions{
+		FoldAccents: gs.FoldAccents,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	round.RemainingAttempts -= 1
+	round.Results = append(round.Results, result)
+	round.logEvent(RoundEvent{
+		Type: GuessSubmitted,
+		Word: word,
+		Results: []wengine.ValidationResult{result},
+	})
+	return result.Match, nil
 }
 
-// Start a new round with a new guess word
+// Start a new round with a new guess word, using the word length, maximum number of tries and boards of the game.
 func (gs *GameState) NewRound() {
-	gs.SaveState.CurrentGame.SecretWord = wengine.WordListCache.GetRandomWord(config.GlobalConfig.UserConfig.WordLength)
-	gs.SaveState.CurrentGame.RemainingAttempts = config.GlobalConfig.UserConfig.MaxTries
+	wordLength := gs.GetWordLength()
+	maxTries := gs.GetRoundMaxTries()
+	gs.SaveState.CurrentGame.Boards = nil
+	if gs.Daily {
+		today := DailyDate(time.Now())
+		gs.SaveState.CurrentGame.SecretWord = wengine.WordListCache.GetDailyWord(wordLength, today, wengine.WordListCache.Locale)
+		gs.SaveState.CurrentGame.DailyDate = today
+	} else {
+		gs.SaveState.CurrentGame.SecretWord = wengine.WordListCache.GetRandomWord(wordLength)
+		gs.SaveState.CurrentGame.DailyDate = ""
+	}
+	if boards := gs.GetBoardCount(); boards > 1 {
+		gs.SaveState.CurrentGame.Boards = newBoards(boards, wordLength, gs.SaveState.CurrentGame.DailyDate)
+		gs.SaveState.CurrentGame.SecretWord = ""
+	}
+	gs.SaveState.CurrentGame.Absurdle = gs.Absurdle
+	gs.SaveState.CurrentGame.HardMode = gs.HardMode
+	gs.SaveState.CurrentGame.Candidates = nil
+	if gs.Absurdle {
+		gs.SaveState.CurrentGame.Candidates = newAbsurdleCandidates(wordLength)
+	}
+	gs.SaveState.CurrentGame.Events = nil
+	gs.SaveState.CurrentGame.logEvent(RoundEvent{
+		Type: RoundStarted,
+	})
+	gs.SaveState.CurrentGame.WordLength = wordLength
+	gs.SaveState.CurrentGame.MaxTries = maxTries
+	gs.SaveState.CurrentGame.RemainingAttempts = maxTries
 	gs.SaveState.CurrentGame.Results = nil
 	gs.SaveState.CurrentGame.Win = false
 }
 
-// Set win condition for the current round
-func (gs *GameState) WinRound() {
-	gs.UserPrompt.WinRoundMessage(gs)
-	wengine.WordListCache.SetFilterWord(gs.SaveState.CurrentGame.SecretWord)
-	gs.SaveState.CurrentGame.Win = true
-	gs.SaveState.PastGames = append(gs.SaveState.PastGames, gs.SaveState.CurrentGame)
-	gs.Renderer.RenderGameScore(gs)
-	gs.NewRound()
+// Keeps the secret words of the current round from being picked again.
+func (gs *GameState) filterSecretWords() {
+	for _, board := range gs.SaveState.CurrentGame.GetBoards() {
+		wengine.WordListCache.SetFilterWord(board.SecretWord)
+	}
 }
 
-// Set lose condition for the current round.
-func (gs *GameState) LoseRound() {
-	gs.UserPrompt.LoseRoundMessage(gs)
-	wengine.WordListCache.SetFilterWord(gs.SaveState.CurrentGame.SecretWord)
-	gs.SaveState.PastGames = append(gs.SaveState.PastGames, gs.SaveState.CurrentGame)
-	gs.Renderer.RenderGameScore(gs)
-	gs.NewRound()
+// Ends the current round as a win or a loss, records it in the past rounds and returns it.
+// A new round is started, unless the game is the daily puzzle, which can only be played once per day.
+func (gs *GameState) endRound(win bool) GameRound {
+	round := &gs.SaveState.CurrentGame
+	event := RoundLost
+	if win {
+		event = RoundWon
+	}
+	round.logEvent(RoundEvent{
+		Type: event,
+	})
+	round.Win = win
+	round.Candidates = nil
+	if !gs.KeepSecretWords {
+		gs.filterSecretWords()
+	}
+	if round.DailyDate != "" {
+		gs.SaveState.LastDailyDate = round.DailyDate
+	}
+	finished := *round
+	gs.SaveState.PastGames = append(gs.SaveState.PastGames, finished)
+	if !gs.Daily {
+		gs.NewRound()
+	}
+	return finished
 }
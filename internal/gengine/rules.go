@@ -0,0 +1,76 @@
+package gengine
+
+import (
+	"errors"
+
+	"github.com/tanmancan/gwordle/v1/internal/config"
+	"github.com/tanmancan/gwordle/v1/internal/wengine"
+)
+
+// Returned when a game is configured with less than one try.
+var ErrInvalidMaxTries = errors.New("The maximum number of tries must be at least 1.")
+
+// Returns the word length of the round.
+// Rounds saved before the word length was recorded use the length of the secret word.
+func (gr *GameRound) GetWordLength() int {
+	if gr.WordLength > 0 {
+		return gr.WordLength
+	}
+	return wengine.WordLength(gr.SecretWord)
+}
+
+// Returns the maximum number of tries of the round.
+// Rounds saved before the maximum number of tries was recorded use the tries remaining plus the tries used.
+func (gr *GameRound) GetMaxTries() int {
+	if gr.MaxTries > 0 {
+		return gr.MaxTries
+	}
+	return gr.RemainingAttempts + gr.GetTries()
+}
+
+// Returns the word length used for new rounds.
+// Defaults to AppConfig.UserConfig.WordLength.
+func (gs *GameState) GetWordLength() int {
+	if gs.WordLength != 0 {
+		return gs.WordLength
+	}
+	return config.GlobalConfig.UserConfig.WordLength
+}
+
+// Returns the maximum number of tries used for new rounds.
+// Defaults to AppConfig.UserConfig.MaxTries.
+func (gs *GameState) GetMaxTries() int {
+	if gs.MaxTries != 0 {
+		return gs.MaxTries
+	}
+	return config.GlobalConfig.UserConfig.MaxTries
+}
+
+// Returns the maximum number of tries of a new round.
+// Every board after the first adds a try, so 2 boards have 7 tries, 4 boards 9 and 8 boards 13 with the default of 6.
+func (gs *GameState) GetRoundMaxTries() int {
+	return gs.GetMaxTries() + gs.GetBoardCount() - 1
+}
+
+// Checks that new rounds can be played with the word length, maximum number of tries and boards of the game.
+// Returns ErrInvalidMaxTries, ErrInvalidBoards, ErrHardModeBoards, ErrAbsurdleBoards or a *wengine.NotEnoughWordsError.
+func (gs *GameState) ValidateRules() error {
+	if gs.GetMaxTries() < 1 {
+		return ErrInvalidMaxTries
+	}
+	boards := gs.GetBoardCount()
+	validBoards := false
+	for _, count := range BoardCounts {
+		validBoards = validBoards || boards == count
+	}
+	if !validBoards {
+		return ErrInvalidBoards
+	}
+	if gs.HardMode && boards > 1 {
+		return ErrHardModeBoards
+	}
+	if gs.Absurdle && boards > 1 {
+		return ErrAbsurdleBoards
+	}
+	return wengine.WordListCache.ValidateWordLength(gs.GetWordLength())
+}
@@ -0,0 +1,159 @@
+package gengine
+
+import (
+	"testing"
+
+	"github.com/tanmancan/gwordle/v1/internal/wengine"
+)
+
+func TestGameRound_GetWordLength(t *testing.T) {
+	tests := []struct {
+		name  string
+		round GameRound
+		want  int
+	}{
+		{
+			name: "Word length saved with the round",
+			round: GameRound{
+				SecretWord: "swill",
+				WordLength: 5,
+			},
+			want: 5,
+		},
+		{
+			name: "Round saved without a word length",
+			round: GameRound{
+				SecretWord: "árbol",
+			},
+			want: 5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.round.GetWordLength(); got != tt.want {
+				t.Errorf("GameRound.GetWordLength() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGameRound_GetMaxTries(t *testing.T) {
+	tests := []struct {
+		name  string
+		round GameRound
+		want  int
+	}{
+		{
+			name: "Maximum number of tries saved with the round",
+			round: GameRound{
+				RemainingAttempts: 2,
+				Results:           make([]wengine.ValidationResult, 3),
+				MaxTries:          8,
+			},
+			want: 8,
+		},
+		{
+			name: "Round saved without a maximum number of tries",
+			round: GameRound{
+				RemainingAttempts: 2,
+				Results:           make([]wengine.ValidationResult, 3),
+			},
+			want: 5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.round.GetMaxTries(); got != tt.want {
+				t.Errorf("GameRound.GetMaxTries() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGameState_ValidateRules(t *testing.T) {
+	words := make(map[int][]string)
+	for _, w := range []string{"ab", "cd", "ef", "gh", "ij", "kl", "mn", "op", "qr", "st"} {
+		words[2] = append(words[2], w)
+	}
+	words[3] = []string{"abc", "def"}
+	prev := wengine.WordListCache
+	t.Cleanup(func() { wengine.WordListCache = prev })
+	wengine.WordListCache = wengine.WordList{
+		Words: words,
+	}
+	tests := []struct {
+		name    string
+		gs      GameState
+		wantErr bool
+	}{
+		{
+			name: "Enough words",
+			gs: GameState{
+				WordLength: 2,
+				MaxTries:   6,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Not enough words",
+			gs: GameState{
+				WordLength: 3,
+				MaxTries:   6,
+			},
+			wantErr: true,
+		},
+		{
+			name: "No tries",
+			gs: GameState{
+				WordLength: 2,
+				MaxTries:   -1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Four boards",
+			gs: GameState{
+				WordLength: 2,
+				MaxTries:   6,
+				Boards:     4,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Unsupported number of boards",
+			gs: GameState{
+				WordLength: 2,
+				MaxTries:   6,
+				Boards:     3,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Hard mode with two boards",
+			gs: GameState{
+				HardMode:   true,
+				WordLength: 2,
+				MaxTries:   6,
+				Boards:     2,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Adversarial mode with two boards",
+			gs: GameState{
+				Absurdle:   true,
+				WordLength: 2,
+				MaxTries:   6,
+				Boards:     2,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.gs.ValidateRules(); (err != nil) != tt.wantErr {
+				t.Errorf("GameState.ValidateRules() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
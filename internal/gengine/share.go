@@ -0,0 +1,68 @@
+package gengine
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tanmancan/gwordle/v1/internal/wengine"
+)
+
+// Emoji tiles used in the share grid, keyed by character status.
+var ShareTiles = map[wengine.CharValidationStatus]string{
+	wengine.ValidPosition: "🟩",
+	wengine.InvalidPosition: "🟨",
+	wengine.InvalidCharacter: "⬛",
+}
+
+// Render the results of a round as an emoji grid that can be pasted into chat.
+// Only the status of each character is included, never the characters themselves.
+// The header shows the number of tries, or X if the round was not won, and a * if the round was played in hard mode.
+// A multi-board round has a grid for each board, separated by a blank line.
+func ShareText(round GameRound) string {
+	var sb strings.Builder
+	maxTries := round.GetMaxTries()
+
+	tries := "X"
+	if round.Win {
+		tries = fmt.Sprintf("%d", round.GetTries())
+	}
+
+	sb.WriteString("gwordle ")
+	if round.DailyDate != "" {
+		sb.WriteString(round.DailyDate + " ")
+	}
+	sb.WriteString(fmt.Sprintf("%s/%d", tries, maxTries))
+	if round.HardMode {
+		sb.WriteString("*")
+	}
+	sb.WriteString("\n")
+
+	for i, board := range round.GetBoards() {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		for _, result := range board.Results {
+			sb.WriteString("\n")
+			for _, c := range result.Chars {
+				sb.WriteString(ShareTiles[c.Status])
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+// Get the round to share. This is the current round if it has any guesses, otherwise the last past round.
+// Returns false if there is nothing to share.
+func (gs *GameState) GetShareRound() (GameRound, bool) {
+	if gs.SaveState.CurrentGame.GetTries() > 0 {
+		return gs.SaveState.CurrentGame, true
+	}
+
+	pastCount := len(gs.SaveState.PastGames)
+	if pastCount > 0 && gs.SaveState.PastGames[pastCount-1].GetTries() > 0 {
+		return gs.SaveState.PastGames[pastCount-1], true
+	}
+
+	return GameRound{}, false
+}
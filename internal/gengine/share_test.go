@@ -0,0 +1,74 @@
+package gengine
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tanmancan/gwordle/v1/internal/wengine"
+)
+
+func TestShareText(t *testing.T) {
+	first, _ := wengine.ValidateWord("crate", "sport")
+	second, _ := wengine.ValidateWord("sport", "sport")
+	type args struct {
+		round GameRound
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "Won round",
+			args: args{
+				round: GameRound{
+					RemainingAttempts: 4,
+					Results:           []wengine.ValidationResult{first, second},
+					SecretWord:        "sport",
+					Win:               true,
+				},
+			},
+			want: "gwordle 2/6\n\n⬛🟨⬛🟨⬛\n🟩🟩🟩🟩🟩",
+		},
+		{
+			name: "Lost daily round in hard mode",
+			args: args{
+				round: GameRound{
+					RemainingAttempts: 0,
+					Results:           []wengine.ValidationResult{first},
+					SecretWord:        "sport",
+					DailyDate:         "2022-04-12",
+					HardMode:          true,
+				},
+			},
+			want: "gwordle 2022-04-12 X/1*\n\n⬛🟨⬛🟨⬛",
+		},
+		{
+			name: "Won multi-board round",
+			args: args{
+				round: GameRound{
+					RemainingAttempts: 5,
+					Boards: []Board{
+						{SecretWord: "sport", Results: []wengine.ValidationResult{second}, Win: true},
+						{SecretWord: "sport", Results: []wengine.ValidationResult{first, second}, Win: true},
+					},
+					Win: true,
+				},
+			},
+			want: "gwordle 2/7\n\n🟩🟩🟩🟩🟩\n\n⬛🟨⬛🟨⬛\n🟩🟩🟩🟩🟩",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ShareText(tt.args.round)
+			if got != tt.want {
+				t.Errorf("ShareText() = %q, want %q", got, tt.want)
+			}
+			for _, board := range tt.args.round.GetBoards() {
+				if strings.Contains(strings.ToLower(got), board.SecretWord) {
+					t.Errorf("ShareText() = %q, leaks the secret word %q", got, board.SecretWord)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package gengine
+
+// Player statistics derived from the past rounds.
+type Statistics struct {
+	Played int // Number of rounds played.
+	Wins int // Number of rounds won.
+	Losses int // Number of rounds lost or forfeited.
+	WinPercentage float64 // Percentage of rounds won, from 0 to 100.
+	CurrentStreak int // Number of consecutive wins up to the most recent round.
+	MaxStreak int // Highest number of consecutive wins.
+	GuessDistribution map[int]int // Number of wins keyed by the number of tries it took.
+	BoardsSolved int // Number of boards solved in multi-board rounds.
+	BoardsFailed int // Number of boards left unsolved in multi-board rounds.
+	BoardGuessDistribution map[int]int // Number of solved boards of multi-board rounds keyed by the number of tries it took.
+}
+
+// Compute the player statistics from the past rounds.
+func (s *SaveState) GetStatistics() (st Statistics) {
+	st.GuessDistribution = make(map[int]int)
+	st.BoardGuessDistribution = make(map[int]int)
+	streak := 0
+
+	for _, round := range s.PastGames {
+		st.Played++
+		for _, board := range round.Boards {
+			if board.Win {
+				st.BoardsSolved++
+				st.BoardGuessDistribution[len(board.Results)]++
+			} else {
+				st.BoardsFailed++
+			}
+		}
+		if round.Win {
+			st.Wins++
+			st.GuessDistribution[round.GetTries()]++
+			streak++
+			if streak > st.MaxStreak {
+				st.MaxStreak = streak
+			}
+		} else {
+			st.Losses++
+			streak = 0
+		}
+	}
+
+	st.CurrentStreak = streak
+	if st.Played > 0 {
+		st.WinPercentage = float64(st.Wins) / float64(st.Played) * 100
+	}
+
+	return st
+}
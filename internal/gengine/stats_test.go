@@ -0,0 +1,121 @@
+package gengine
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tanmancan/gwordle/v1/internal/wengine"
+)
+
+// Create a past round with the given outcome and number of tries.
+func pastRound(win bool, tries int) GameRound {
+	return GameRound{
+		Win: win,
+		Results: make([]wengine.ValidationResult, tries),
+	}
+}
+
+func TestSaveState_GetStatistics(t *testing.T) {
+	tests := []struct {
+		name      string
+		pastGames []GameRound
+		want      Statistics
+	}{
+		{
+			name:      "No past games",
+			pastGames: nil,
+			want: Statistics{
+				GuessDistribution:      map[int]int{},
+				BoardGuessDistribution: map[int]int{},
+			},
+		},
+		{
+			name: "Streak is broken by a loss",
+			pastGames: []GameRound{
+				pastRound(true, 3),
+				pastRound(true, 4),
+				pastRound(true, 3),
+				pastRound(false, 6),
+				pastRound(true, 2),
+			},
+			want: Statistics{
+				Played:        5,
+				Wins:          4,
+				Losses:        1,
+				WinPercentage: 80,
+				CurrentStreak: 1,
+				MaxStreak:     3,
+				GuessDistribution: map[int]int{
+					2: 1,
+					3: 2,
+					4: 1,
+				},
+				BoardGuessDistribution: map[int]int{},
+			},
+		},
+		{
+			name: "Current streak is zero after a loss",
+			pastGames: []GameRound{
+				pastRound(true, 1),
+				pastRound(false, 2),
+			},
+			want: Statistics{
+				Played:        2,
+				Wins:          1,
+				Losses:        1,
+				WinPercentage: 50,
+				CurrentStreak: 0,
+				MaxStreak:     1,
+				GuessDistribution: map[int]int{
+					1: 1,
+				},
+				BoardGuessDistribution: map[int]int{},
+			},
+		},
+		{
+			name: "Boards of multi-board rounds",
+			pastGames: []GameRound{
+				{
+					Win: true,
+					Boards: []Board{
+						{Win: true, Results: make([]wengine.ValidationResult, 2)},
+						{Win: true, Results: make([]wengine.ValidationResult, 4)},
+					},
+				},
+				{
+					Boards: []Board{
+						{Win: true, Results: make([]wengine.ValidationResult, 2)},
+						{Results: make([]wengine.ValidationResult, 7)},
+					},
+				},
+			},
+			want: Statistics{
+				Played:        2,
+				Wins:          1,
+				Losses:        1,
+				WinPercentage: 50,
+				CurrentStreak: 0,
+				MaxStreak:     1,
+				GuessDistribution: map[int]int{
+					4: 1,
+				},
+				BoardsSolved: 3,
+				BoardsFailed: 1,
+				BoardGuessDistribution: map[int]int{
+					2: 2,
+					4: 1,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &SaveState{
+				PastGames: tt.pastGames,
+			}
+			if got := s.GetStatistics(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SaveState.GetStatistics() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,315 @@
+package localization
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+)
+
+// Severity of a translation issue.
+type Severity string
+
+const (
+	// The translation is broken. Example: a format verb that does not match the English text.
+	SeverityError Severity = "error"
+	// The translation is incomplete, so the English text is used instead.
+	SeverityWarning Severity = "warning"
+)
+
+// A problem found in the translations of a locale, compared to the English base.
+type Issue struct {
+	Locale language.Tag
+	Key string // Path of the translation. Example: endRound.winMessage
+	Severity Severity
+	Message string
+}
+
+// Describes the issue on a single line.
+func (i Issue) String() string {
+	return fmt.Sprintf("%s: %s: %s: %s", i.Locale, i.Severity, i.Key, i.Message)
+}
+
+// Returns true if any of the issues is an error.
+func HasErrors(issues []Issue) bool {
+	for _, issue := range issues {
+		if issue.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// Get the key of a struct field in the translation files. Example: WinMessage is winMessage
+func fieldKey(name string) string {
+	r, size := utf8.DecodeRuneInString(name)
+	return string(unicode.ToLower(r)) + name[size:]
+}
+
+var pluralType = reflect.TypeOf(Plural{})
+
+// Get the path of every required translation in the struct type. Example: endRound.loseMessage
+// Only the other form of a Plural is required. Example: endRound.winMessage.other
+func translationKeys(structType reflect.Type, prefix string) (keys []string) {
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		path := prefix + fieldKey(field.Name)
+		if field.Type == pluralType {
+			keys = append(keys, path+".other")
+			continue
+		}
+		if field.Type.Kind() == reflect.Struct {
+			keys = append(keys, translationKeys(field.Type, path+".")...)
+			continue
+		}
+		keys = append(keys, path)
+	}
+	return keys
+}
+
+// Map the translations in a JSON object to the fields of the struct type, the same way encoding/json maps them.
+// Returns the translations keyed by their path, and the keys that do not map to a field.
+// Nested keys are joined with a dot. Example: endRound.winCondition
+func flattenTranslations(structType reflect.Type, object map[string]interface{}, prefix string) (values map[string]string, unknown []string) {
+	values = make(map[string]string)
+	for key, value := range object {
+		field, ok := structType.FieldByNameFunc(func(name string) bool {
+			return strings.EqualFold(name, key)
+		})
+		if !ok {
+			unknown = append(unknown, prefix+key)
+			continue
+		}
+
+		path := prefix + fieldKey(field.Name)
+		nested, isObject := value.(map[string]interface{})
+		text, isString := value.(string)
+		switch {
+		case field.Type.Kind() == reflect.Struct && isObject:
+			nestedValues, nestedUnknown := flattenTranslations(field.Type, nested, path+".")
+			for k, v := range nestedValues {
+				values[k] = v
+			}
+			unknown = append(unknown, nestedUnknown...)
+		case field.Type.Kind() == reflect.String && isString:
+			values[path] = text
+		default:
+			unknown = append(unknown, fmt.Sprintf("%s (expected %s)", prefix+key, field.Type.Kind()))
+		}
+	}
+	sort.Strings(unknown)
+	return values, unknown
+}
+
+// Get the path of every Plural in the struct type. Example: endRound.winMessage
+func pluralKeys(structType reflect.Type, prefix string) (keys []string) {
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		path := prefix + fieldKey(field.Name)
+		switch {
+		case field.Type == pluralType:
+			keys = append(keys, path)
+		case field.Type.Kind() == reflect.Struct:
+			keys = append(keys, pluralKeys(field.Type, path+".")...)
+		}
+	}
+	return keys
+}
+
+// Names of the plural forms, as used in the translation files.
+var pluralFormNames = map[plural.Form]string{
+	plural.Zero: "zero",
+	plural.One: "one",
+	plural.Two: "two",
+	plural.Few: "few",
+	plural.Many: "many",
+	plural.Other: "other",
+}
+
+// Get the plural forms the locale uses for counts, other than the other form, sorted by name.
+func pluralForms(locale language.Tag) (forms []string) {
+	used := make(map[string]bool)
+	for count := 0; count <= 1000; count++ {
+		used[pluralFormNames[plural.Cardinal.MatchPlural(locale, count, 0, 0, 0, 0)]] = true
+	}
+	delete(used, "other")
+	for form := range used {
+		forms = append(forms, form)
+	}
+	sort.Strings(forms)
+	return forms
+}
+
+// Get the English text a translation is compared to.
+// Plural forms English does not use are compared to the English other form. Example: endRound.winMessage.few
+func baseText(base map[string]string, key string) (string, bool) {
+	if text, ok := base[key]; ok {
+		return text, true
+	}
+	if i := strings.LastIndex(key, "."); i >= 0 {
+		text, ok := base[key[:i]+".other"]
+		return text, ok
+	}
+	return "", false
+}
+
+// Matches a fmt verb, with its optional argument index, flags, width and precision. Example: %[2]-5.2f
+var formatVerbPattern = regexp.MustCompile(`%(?:\[(\d+)\])?[-+# 0]*(?:\d+|\*)?(?:\.(?:\d+|\*)?)?([a-zA-Z%])`)
+
+// Get the fmt verbs in the text, keyed by the argument they format, starting at 1.
+// Arguments are numbered the same way fmt does, so translations can reorder them with explicit indexes. Example: %[2]s
+func formatVerbs(text string) map[int]string {
+	verbs := make(map[int]string)
+	arg := 1
+	for _, match := range formatVerbPattern.FindAllStringSubmatch(text, -1) {
+		if match[2] == "%" {
+			continue
+		}
+		if match[1] != "" {
+			arg, _ = strconv.Atoi(match[1])
+		}
+		verbs[arg] = match[2]
+		arg++
+	}
+	return verbs
+}
+
+// Describes the verbs of a text in argument order. Example: %s %d
+func describeVerbs(verbs map[int]string) string {
+	var args []int
+	for arg := range verbs {
+		args = append(args, arg)
+	}
+	sort.Ints(args)
+
+	var parts []string
+	for _, arg := range args {
+		parts = append(parts, "%"+verbs[arg])
+	}
+	if len(parts) == 0 {
+		return "none"
+	}
+	return strings.Join(parts, " ")
+}
+
+// Read a translation file into its translations keyed by path, and the keys that do not map to Translatables fields.
+func parseTranslations(data []byte) (map[string]string, []string, error) {
+	var object map[string]interface{}
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, nil, err
+	}
+	values, unknown := flattenTranslations(reflect.TypeOf(Translatables{}), object, "")
+	return values, unknown, nil
+}
+
+// Check the translation file of a locale against the English translations.
+// Unknown keys and format verbs that do not match the English text are errors.
+// Missing keys are warnings, since the English text is used instead, except for English itself.
+func CheckLocale(locale language.Tag, data []byte, base map[string]string) (issues []Issue) {
+	values, unknown, err := parseTranslations(data)
+	if err != nil {
+		return []Issue{{
+			Locale: locale,
+			Key: "-",
+			Severity: SeverityError,
+			Message: fmt.Sprintf("invalid JSON: %v", err),
+		}}
+	}
+
+	for _, key := range unknown {
+		issues = append(issues, Issue{
+			Locale: locale,
+			Key: key,
+			Severity: SeverityError,
+			Message: "unknown key, it does not map to a Translatables field",
+		})
+	}
+
+	missingSeverity := SeverityWarning
+	if locale == language.English {
+		missingSeverity = SeverityError
+	}
+
+	for _, key := range translationKeys(reflect.TypeOf(Translatables{}), "") {
+		if _, ok := values[key]; !ok {
+			issues = append(issues, Issue{
+				Locale: locale,
+				Key: key,
+				Severity: missingSeverity,
+				Message: "missing translation",
+			})
+		}
+	}
+
+	forms := pluralForms(locale)
+	for _, key := range pluralKeys(reflect.TypeOf(Translatables{}), "") {
+		if _, ok := values[key+".other"]; !ok {
+			continue
+		}
+		for _, form := range forms {
+			if _, ok := values[key+"."+form]; !ok {
+				issues = append(issues, Issue{
+					Locale: locale,
+					Key: key + "." + form,
+					Severity: SeverityWarning,
+					Message: "missing plural form, the other form is used instead",
+				})
+			}
+		}
+	}
+
+	var keys []string
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		baseText, ok := baseText(base, key)
+		if !ok {
+			continue
+		}
+		want := formatVerbs(baseText)
+		got := formatVerbs(values[key])
+		if !reflect.DeepEqual(got, want) {
+			issues = append(issues, Issue{
+				Locale: locale,
+				Key: key,
+				Severity: SeverityError,
+				Message: fmt.Sprintf("format verbs %s do not match the English %s", describeVerbs(got), describeVerbs(want)),
+			})
+		}
+	}
+
+	return issues
+}
+
+// Check the translations of every locale under static against the English translations.
+func CheckLocales() (issues []Issue, err error) {
+	baseData, err := translatableFs.ReadFile(fmt.Sprintf("static/%s/translatables.json", language.English))
+	if err != nil {
+		return nil, err
+	}
+	base, _, err := parseTranslations(baseData)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, locale := range Locales {
+		data, err := translatableFs.ReadFile(fmt.Sprintf("static/%s/translatables.json", locale))
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, CheckLocale(locale, data, base)...)
+	}
+
+	return issues, nil
+}
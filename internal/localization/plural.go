@@ -0,0 +1,63 @@
+package localization
+
+import (
+	"encoding/json"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+)
+
+// A message with a form for each CLDR plural category, selected by a count.
+// Only Other is required. Forms a language does not use can be left out, and missing forms use Other.
+//
+//	"remainingAttempts": {"one": "You have %d try: ", "other": "You have %d tries: "}
+type Plural struct {
+	Zero string
+	One string
+	Two string
+	Few string
+	Many string
+	Other string
+}
+
+// Replaces every form, so forms from a fallback locale are never mixed with the forms of a more specific one.
+func (p *Plural) UnmarshalJSON(data []byte) error {
+	type forms Plural
+	var f forms
+	if err := json.Unmarshal(data, &f); err != nil {
+		return err
+	}
+	*p = Plural(f)
+	return nil
+}
+
+// Get the form of the message for the count, using the plural rules of the loaded locale.
+func (p Plural) Select(count int) string {
+	return p.SelectFor(Locale, count)
+}
+
+// Get the form of the message for the count, using the plural rules of the given locale.
+func (p Plural) SelectFor(locale language.Tag, count int) string {
+	if count < 0 {
+		count = -count
+	}
+
+	var text string
+	switch plural.Cardinal.MatchPlural(locale, count, 0, 0, 0, 0) {
+	case plural.Zero:
+		text = p.Zero
+	case plural.One:
+		text = p.One
+	case plural.Two:
+		text = p.Two
+	case plural.Few:
+		text = p.Few
+	case plural.Many:
+		text = p.Many
+	}
+
+	if text == "" {
+		return p.Other
+	}
+	return text
+}
@@ -0,0 +1,78 @@
+package localization
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestPlural_SelectFor(t *testing.T) {
+	tries := Plural{
+		One:   "%d try",
+		Other: "%d tries",
+	}
+	tests := []struct {
+		name   string
+		plural Plural
+		locale language.Tag
+		count  int
+		want   string
+	}{
+		{
+			name:   "English one",
+			plural: tries,
+			locale: language.English,
+			count:  1,
+			want:   "%d try",
+		},
+		{
+			name:   "English other",
+			plural: tries,
+			locale: language.English,
+			count:  2,
+			want:   "%d tries",
+		},
+		{
+			name:   "English zero uses other",
+			plural: tries,
+			locale: language.English,
+			count:  0,
+			want:   "%d tries",
+		},
+		{
+			name:   "Spanish one",
+			plural: tries,
+			locale: language.Spanish,
+			count:  1,
+			want:   "%d try",
+		},
+		{
+			name: "Missing form uses other",
+			plural: Plural{
+				Other: "%d tries",
+			},
+			locale: language.English,
+			count:  1,
+			want:   "%d tries",
+		},
+		{
+			name: "Polish few",
+			plural: Plural{
+				One:   "%d próba",
+				Few:   "%d próby",
+				Many:  "%d prób",
+				Other: "%d próby",
+			},
+			locale: language.Polish,
+			count:  5,
+			want:   "%d prób",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.plural.SelectFor(tt.locale, tt.count); got != tt.want {
+				t.Errorf("Plural.SelectFor() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -21,6 +21,14 @@ type Translatables struct {
 		NewDesc string
 		Hide string
 		HideDesc string
+		Hint string
+		HintDesc string
+		Share string
+		ShareDesc string
+		Replay string
+		ReplayDesc string
+		Undo string
+		UndoDesc string
 		Exit string
 		ExitDesc string
 		InvalidCommand string
@@ -28,53 +36,140 @@ type Translatables struct {
 	}
 	UserPrompt struct {
 		Instructions string
-		RemainingAttempts string
+		RemainingAttempts Plural
 	}
 	ScoreCard struct {
 		TotalWin string
 		TotalLoss string
+		WinPercentage string
+		CurrentStreak string
+		MaxStreak string
+		GuessDistribution string
+		BoardsSolved string
+		BoardsFailed string
+		BoardGuessDistribution string
 	}
 	Validation struct {
 		InvalidWord string
+		UnknownWord string
+		WrongLength string
+		HardModePosition string
+		HardModePresent string
 	}
 	EndRound struct {
-		Try string
-		Tries string
-		WinMessage string
+		WinMessage Plural
 		LoseMessage string
 	}
+	Hint struct {
+		Remaining string
+		Suggestion string
+	}
+	Share struct {
+		Option string
+		Saved string
+		NothingToShare string
+	}
+	Replay struct {
+		Intro string
+		Next string
+		Step string
+		Started string
+		Guess string
+		Hint string
+		Undo string
+		Forfeit string
+		Win string
+		Lose string
+		End string
+		NothingToReplay string
+		InvalidRound string
+	}
+	Undo struct {
+		Done string
+		NothingToUndo string
+		Daily string
+	}
+	Daily struct {
+		Completed string
+	}
+	Rules struct {
+		InvalidMaxTries string
+		NotEnoughWords string
+		InvalidBoards string
+		HardModeBoards string
+		AbsurdleBoards string
+	}
 	HideRound struct {
 		Return string
 		Exit string
 		Instructions string
 		InvalidInput string
 	}
+	// Full-screen terminal UI.
+	Tui struct {
+		Instructions string
+	}
 }
 
 var (
 	//go:embed static
 	translatableFs embed.FS
 	AppTranslatable Translatables
+	// Locales with translations under static.
+	Locales []language.Tag
+	// Most specific locale of the loaded translations.
+	Locale language.Tag
 )
 
-// Merge current language with base english text. This will ensure any missing
-// labels are replaced with the original english values.
 func init() {
-	langEs := language.English.String()
-	fPathEs := fmt.Sprintf("static/%s/translatables.json", langEs)
-	translatableFileEs, err := translatableFs.ReadFile(fPathEs)
+	Locales = availableLocales()
+	SetLocale(config.GlobalConfig.Locale)
+}
+
+// Get the locales with translations under static, with English first so it is used when nothing matches.
+func availableLocales() []language.Tag {
+	locales := []language.Tag{language.English}
+	entries, err := translatableFs.ReadDir("static")
 	if err != nil {
 		log.Fatalln(err)
 	}
-	json.Unmarshal(translatableFileEs, &AppTranslatable)
+	for _, entry := range entries {
+		tag, err := language.Parse(entry.Name())
+		if err != nil || !entry.IsDir() || tag == language.English {
+			continue
+		}
+		locales = append(locales, tag)
+	}
+	return locales
+}
+
+// Load the translations for the supported locale closest to the given locale.
+// English is loaded first, then each locale in the fallback chain from the most general to the most specific,
+// so any missing labels are replaced with the closest available values. Example: en, then es, then es-MX.
+func SetLocale(tag language.Tag) language.Tag {
+	Locale = config.MatchLocale(tag, Locales)
 
-	if config.GlobalConfig.Locale != language.English {
-		lang := config.GlobalConfig.Locale.String()
-		fPath := fmt.Sprintf("static/%s/translatables.json", lang)
-		translatableFile, err := translatableFs.ReadFile(fPath)
-		if err != nil {
-			log.Fatalln(err)
+	AppTranslatable = Translatables{}
+	loadTranslatables(language.English)
+
+	fallbacks := config.LocaleFallbacks(Locale)
+	for i := len(fallbacks) - 1; i >= 0; i-- {
+		if fallbacks[i] != language.English {
+			loadTranslatables(fallbacks[i])
 		}
-		json.Unmarshal(translatableFile, &AppTranslatable)
+	}
+
+	return Locale
+}
+
+// Merge the translations for the locale into AppTranslatable. Locales without translations are skipped.
+func loadTranslatables(tag language.Tag) {
+	fPath := fmt.Sprintf("static/%s/translatables.json", tag.String())
+	translatableFile, err := translatableFs.ReadFile(fPath)
+	if err != nil {
+		return
+	}
+	if err := json.Unmarshal(translatableFile, &AppTranslatable); err != nil {
+		log.Println(fPath, err)
 	}
 }
@@ -0,0 +1,245 @@
+package localization
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func Test_flattenTranslations(t *testing.T) {
+	tests := []struct {
+		name        string
+		json        string
+		wantValues  map[string]string
+		wantUnknown []string
+	}{
+		{
+			name: "All keys map to fields",
+			json: `{"commands": {"help": "ayuda"}, "endRound": {"loseMessage": "Has perdido"}}`,
+			wantValues: map[string]string{
+				"commands.help":        "ayuda",
+				"endRound.loseMessage": "Has perdido",
+			},
+			wantUnknown: nil,
+		},
+		{
+			name: "Keys are matched without case",
+			json: `{"userPrompt": {"Instructions": "instrucciones"}}`,
+			wantValues: map[string]string{
+				"userPrompt.instructions": "instrucciones",
+			},
+			wantUnknown: nil,
+		},
+		{
+			name:        "Unknown nested key",
+			json:        `{"endRound": {"winCondition": {"try": "tratar"}}}`,
+			wantValues:  map[string]string{},
+			wantUnknown: []string{"endRound.winCondition"},
+		},
+		{
+			name:        "Unknown section",
+			json:        `{"commandos": {"help": "ayuda"}}`,
+			wantValues:  map[string]string{},
+			wantUnknown: []string{"commandos"},
+		},
+		{
+			name:        "Object instead of a string",
+			json:        `{"commands": {"help": {"one": "ayuda"}}}`,
+			wantValues:  map[string]string{},
+			wantUnknown: []string{"commands.help (expected string)"},
+		},
+		{
+			name: "Plural forms",
+			json: `{"endRound": {"winMessage": {"one": "en %d intento", "other": "en %d intentos"}}}`,
+			wantValues: map[string]string{
+				"endRound.winMessage.one":   "en %d intento",
+				"endRound.winMessage.other": "en %d intentos",
+			},
+			wantUnknown: nil,
+		},
+		{
+			name:        "String instead of plural forms",
+			json:        `{"endRound": {"winMessage": "en %d intentos"}}`,
+			wantValues:  map[string]string{},
+			wantUnknown: []string{"endRound.winMessage (expected struct)"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var object map[string]interface{}
+			if err := json.Unmarshal([]byte(tt.json), &object); err != nil {
+				t.Fatal(err)
+			}
+			gotValues, gotUnknown := flattenTranslations(reflect.TypeOf(Translatables{}), object, "")
+			if !reflect.DeepEqual(gotValues, tt.wantValues) {
+				t.Errorf("flattenTranslations() values = %v, want %v", gotValues, tt.wantValues)
+			}
+			if !reflect.DeepEqual(gotUnknown, tt.wantUnknown) {
+				t.Errorf("flattenTranslations() unknown = %v, want %v", gotUnknown, tt.wantUnknown)
+			}
+		})
+	}
+}
+
+func Test_formatVerbs(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want map[int]string
+	}{
+		{
+			name: "No verbs",
+			text: "Available commands:",
+			want: map[int]string{},
+		},
+		{
+			name: "Verbs with flags, width and precision",
+			text: "%s (%.2f bits) %-5d",
+			want: map[int]string{
+				1: "s",
+				2: "f",
+				3: "d",
+			},
+		},
+		{
+			name: "Escaped percent sign",
+			text: "Win percentage: %.0f%%",
+			want: map[int]string{
+				1: "f",
+			},
+		},
+		{
+			name: "Explicit argument indexes",
+			text: "%[2]d letras, %[1]s",
+			want: map[int]string{
+				1: "s",
+				2: "d",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatVerbs(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("formatVerbs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckLocale(t *testing.T) {
+	base := map[string]string{
+		"userPrompt.remainingAttempts.one":   "You have %d try: ",
+		"userPrompt.remainingAttempts.other": "You have %d tries: ",
+		"endRound.winMessage.other":          "You have guessed the correct word (%s) in %d tries!\n",
+	}
+	tests := []struct {
+		name string
+		json string
+		want []Issue
+	}{
+		{
+			name: "Format verb changed",
+			json: `{"userPrompt": {"remainingAttempts": {"other": "Te quedan %s intentos: "}}}`,
+			want: []Issue{{
+				Locale:   language.Spanish,
+				Key:      "userPrompt.remainingAttempts.other",
+				Severity: SeverityError,
+				Message:  "format verbs %s do not match the English %d",
+			}},
+		},
+		{
+			name: "Format verb removed",
+			json: `{"userPrompt": {"remainingAttempts": {"one": "Te queda un intento: ", "other": "Te quedan %d intentos: "}}}`,
+			want: []Issue{{
+				Locale:   language.Spanish,
+				Key:      "userPrompt.remainingAttempts.one",
+				Severity: SeverityError,
+				Message:  "format verbs none do not match the English %d",
+			}},
+		},
+		{
+			name: "Arguments reordered with explicit indexes",
+			json: `{"endRound": {"winMessage": {"other": "¡En %[2]d intentos: %[1]s!"}}}`,
+			want: nil,
+		},
+		{
+			name: "Plural form English does not use is checked against the other form",
+			json: `{"endRound": {"winMessage": {"many": "¡%s en %s intentos!", "other": "¡%s en %d intentos!"}}}`,
+			want: []Issue{{
+				Locale:   language.Spanish,
+				Key:      "endRound.winMessage.many",
+				Severity: SeverityError,
+				Message:  "format verbs %s %s do not match the English %s %d",
+			}},
+		},
+		{
+			name: "Unknown key",
+			json: `{"endRound": {"winCondition": "tratar"}}`,
+			want: []Issue{{
+				Locale:   language.Spanish,
+				Key:      "endRound.winCondition",
+				Severity: SeverityError,
+				Message:  "unknown key, it does not map to a Translatables field",
+			}},
+		},
+		{
+			name: "Invalid JSON",
+			json: `{"endRound": `,
+			want: []Issue{{
+				Locale:   language.Spanish,
+				Key:      "-",
+				Severity: SeverityError,
+				Message:  "invalid JSON: unexpected end of JSON input",
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []Issue
+			for _, issue := range CheckLocale(language.Spanish, []byte(tt.json), base) {
+				if issue.Severity == SeverityError {
+					got = append(got, issue)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CheckLocale() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckLocale_pluralForms(t *testing.T) {
+	base := map[string]string{
+		"endRound.winMessage.one":   "You have guessed the correct word (%s) in %d try!\n",
+		"endRound.winMessage.other": "You have guessed the correct word (%s) in %d tries!\n",
+	}
+	json := `{"endRound": {"winMessage": {"other": "¡%s en %d intentos!"}}}`
+	want := Issue{
+		Locale:   language.Spanish,
+		Key:      "endRound.winMessage.one",
+		Severity: SeverityWarning,
+		Message:  "missing plural form, the other form is used instead",
+	}
+	for _, issue := range CheckLocale(language.Spanish, []byte(json), base) {
+		if issue == want {
+			return
+		}
+	}
+	t.Errorf("CheckLocale() is missing %v", want)
+}
+
+// Every key in every locale must map to a Translatables field and use the same format verbs as English,
+// otherwise the translation is silently ignored or prints %!d.
+func TestCheckLocales(t *testing.T) {
+	issues, err := CheckLocales()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, issue := range issues {
+		if issue.Severity == SeverityError {
+			t.Error(issue)
+		}
+	}
+}
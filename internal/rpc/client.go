@@ -0,0 +1,79 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/tanmancan/gwordle/v1/internal/rpc/gwordlepb"
+	"github.com/tanmancan/gwordle/v1/internal/server"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Thin client for the gwordle gRPC service.
+type Client struct {
+	conn *grpc.ClientConn
+	api gwordlepb.GwordleClient
+}
+
+// Connect to a gwordle gRPC server at the given address.
+func Dial(addr string, opts ...grpc.DialOption) (*Client, error) {
+	if len(opts) == 0 {
+		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+	conn, err := grpc.NewClient(addr, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		conn: conn,
+		api: gwordlepb.NewGwordleClient(conn),
+	}, nil
+}
+
+// Close the connection to the server.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Get the generated client, for streaming and any call not wrapped by Client.
+func (c *Client) API() gwordlepb.GwordleClient {
+	return c.api
+}
+
+// Create a game with the given rules and start its first round.
+func (c *Client) NewGame(ctx context.Context, rules server.GameRules) (*gwordlepb.GameRound, error) {
+	return c.api.NewGame(ctx, &gwordlepb.NewGameRequest{
+		HardMode: rules.HardMode,
+		WordLength: int32(rules.WordLength),
+		MaxTries: int32(rules.MaxTries),
+	})
+}
+
+// Submit a guess word for the current round.
+func (c *Client) Guess(ctx context.Context, gameID string, word string) (*gwordlepb.GuessResponse, error) {
+	return c.api.Guess(ctx, &gwordlepb.GuessRequest{
+		GameId: gameID,
+		Word: word,
+	})
+}
+
+// Get the current round.
+func (c *Client) GetState(ctx context.Context, gameID string) (*gwordlepb.GameRound, error) {
+	return c.api.GetState(ctx, &gwordlepb.GetStateRequest{
+		GameId: gameID,
+	})
+}
+
+// Forfeit the current round and start a new one.
+func (c *Client) Forfeit(ctx context.Context, gameID string) (*gwordlepb.GuessResponse, error) {
+	return c.api.Forfeit(ctx, &gwordlepb.ForfeitRequest{
+		GameId: gameID,
+	})
+}
+
+// Get the total number of wins and losses.
+func (c *Client) GetStats(ctx context.Context, gameID string) (*gwordlepb.Stats, error) {
+	return c.api.GetStats(ctx, &gwordlepb.GetStatsRequest{
+		GameId: gameID,
+	})
+}
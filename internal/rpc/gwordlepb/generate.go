@@ -0,0 +1,4 @@
+// Package gwordlepb contains the generated protobuf messages and gRPC stubs for the gwordle service.
+package gwordlepb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative gwordle.proto
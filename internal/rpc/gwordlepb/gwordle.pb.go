@@ -0,0 +1,799 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: gwordle.proto
+
+package gwordlepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Mirrors wengine.CharValidationStatus.
+type CharValidationStatus int32
+
+const (
+	CharValidationStatus_CHAR_VALIDATION_STATUS_UNSPECIFIED CharValidationStatus = 0
+	// The correct character and position.
+	CharValidationStatus_VALID_POSITION CharValidationStatus = 1
+	// Correct character but invalid position.
+	CharValidationStatus_INVALID_POSITION CharValidationStatus = 2
+	// Invalid character guessed.
+	CharValidationStatus_INVALID_CHARACTER CharValidationStatus = 3
+)
+
+// Enum value maps for CharValidationStatus.
+var (
+	CharValidationStatus_name = map[int32]string{
+		0: "CHAR_VALIDATION_STATUS_UNSPECIFIED",
+		1: "VALID_POSITION",
+		2: "INVALID_POSITION",
+		3: "INVALID_CHARACTER",
+	}
+	CharValidationStatus_value = map[string]int32{
+		"CHAR_VALIDATION_STATUS_UNSPECIFIED": 0,
+		"VALID_POSITION":                     1,
+		"INVALID_POSITION":                   2,
+		"INVALID_CHARACTER":                  3,
+	}
+)
+
+func (x CharValidationStatus) Enum() *CharValidationStatus {
+	p := new(CharValidationStatus)
+	*p = x
+	return p
+}
+
+func (x CharValidationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CharValidationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_gwordle_proto_enumTypes[0].Descriptor()
+}
+
+func (CharValidationStatus) Type() protoreflect.EnumType {
+	return &file_gwordle_proto_enumTypes[0]
+}
+
+func (x CharValidationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CharValidationStatus.Descriptor instead.
+func (CharValidationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_gwordle_proto_rawDescGZIP(), []int{0}
+}
+
+// Mirrors wengine.CharValidationResult.
+type CharValidationResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Char          string                 `protobuf:"bytes,1,opt,name=char,proto3" json:"char,omitempty"`
+	Status        CharValidationStatus   `protobuf:"varint,2,opt,name=status,proto3,enum=gwordle.v1.CharValidationStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CharValidationResult) Reset() {
+	*x = CharValidationResult{}
+	mi := &file_gwordle_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CharValidationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharValidationResult) ProtoMessage() {}
+
+func (x *CharValidationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_gwordle_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharValidationResult.ProtoReflect.Descriptor instead.
+func (*CharValidationResult) Descriptor() ([]byte, []int) {
+	return file_gwordle_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CharValidationResult) GetChar() string {
+	if x != nil {
+		return x.Char
+	}
+	return ""
+}
+
+func (x *CharValidationResult) GetStatus() CharValidationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CharValidationStatus_CHAR_VALIDATION_STATUS_UNSPECIFIED
+}
+
+// Mirrors wengine.ValidationResult.
+type ValidationResult struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Match         bool                    `protobuf:"varint,1,opt,name=match,proto3" json:"match,omitempty"`
+	Chars         []*CharValidationResult `protobuf:"bytes,2,rep,name=chars,proto3" json:"chars,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidationResult) Reset() {
+	*x = ValidationResult{}
+	mi := &file_gwordle_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationResult) ProtoMessage() {}
+
+func (x *ValidationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_gwordle_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationResult.ProtoReflect.Descriptor instead.
+func (*ValidationResult) Descriptor() ([]byte, []int) {
+	return file_gwordle_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ValidationResult) GetMatch() bool {
+	if x != nil {
+		return x.Match
+	}
+	return false
+}
+
+func (x *ValidationResult) GetChars() []*CharValidationResult {
+	if x != nil {
+		return x.Chars
+	}
+	return nil
+}
+
+// Mirrors gengine.GameRound. The secret word is never included.
+type GameRound struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	GameId            string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	HardMode          bool                   `protobuf:"varint,2,opt,name=hard_mode,json=hardMode,proto3" json:"hard_mode,omitempty"`
+	WordLength        int32                  `protobuf:"varint,3,opt,name=word_length,json=wordLength,proto3" json:"word_length,omitempty"`
+	RemainingAttempts int32                  `protobuf:"varint,4,opt,name=remaining_attempts,json=remainingAttempts,proto3" json:"remaining_attempts,omitempty"`
+	Results           []*ValidationResult    `protobuf:"bytes,5,rep,name=results,proto3" json:"results,omitempty"`
+	MaxTries          int32                  `protobuf:"varint,6,opt,name=max_tries,json=maxTries,proto3" json:"max_tries,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GameRound) Reset() {
+	*x = GameRound{}
+	mi := &file_gwordle_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameRound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameRound) ProtoMessage() {}
+
+func (x *GameRound) ProtoReflect() protoreflect.Message {
+	mi := &file_gwordle_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameRound.ProtoReflect.Descriptor instead.
+func (*GameRound) Descriptor() ([]byte, []int) {
+	return file_gwordle_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GameRound) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameRound) GetHardMode() bool {
+	if x != nil {
+		return x.HardMode
+	}
+	return false
+}
+
+func (x *GameRound) GetWordLength() int32 {
+	if x != nil {
+		return x.WordLength
+	}
+	return 0
+}
+
+func (x *GameRound) GetRemainingAttempts() int32 {
+	if x != nil {
+		return x.RemainingAttempts
+	}
+	return 0
+}
+
+func (x *GameRound) GetResults() []*ValidationResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *GameRound) GetMaxTries() int32 {
+	if x != nil {
+		return x.MaxTries
+	}
+	return 0
+}
+
+type NewGameRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	HardMode bool                   `protobuf:"varint,1,opt,name=hard_mode,json=hardMode,proto3" json:"hard_mode,omitempty"`
+	// Zero uses the server default.
+	WordLength int32 `protobuf:"varint,2,opt,name=word_length,json=wordLength,proto3" json:"word_length,omitempty"`
+	// Zero uses the server default.
+	MaxTries      int32 `protobuf:"varint,3,opt,name=max_tries,json=maxTries,proto3" json:"max_tries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewGameRequest) Reset() {
+	*x = NewGameRequest{}
+	mi := &file_gwordle_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewGameRequest) ProtoMessage() {}
+
+func (x *NewGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gwordle_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewGameRequest.ProtoReflect.Descriptor instead.
+func (*NewGameRequest) Descriptor() ([]byte, []int) {
+	return file_gwordle_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *NewGameRequest) GetHardMode() bool {
+	if x != nil {
+		return x.HardMode
+	}
+	return false
+}
+
+func (x *NewGameRequest) GetWordLength() int32 {
+	if x != nil {
+		return x.WordLength
+	}
+	return 0
+}
+
+func (x *NewGameRequest) GetMaxTries() int32 {
+	if x != nil {
+		return x.MaxTries
+	}
+	return 0
+}
+
+type GuessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Word          string                 `protobuf:"bytes,2,opt,name=word,proto3" json:"word,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuessRequest) Reset() {
+	*x = GuessRequest{}
+	mi := &file_gwordle_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuessRequest) ProtoMessage() {}
+
+func (x *GuessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gwordle_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuessRequest.ProtoReflect.Descriptor instead.
+func (*GuessRequest) Descriptor() ([]byte, []int) {
+	return file_gwordle_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GuessRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GuessRequest) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+// Outcome of a guess or a forfeit. secret_word is only set once the round has
+// ended, and state then holds the new round.
+type GuessResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Result     *ValidationResult      `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Win        bool                   `protobuf:"varint,2,opt,name=win,proto3" json:"win,omitempty"`
+	Lose       bool                   `protobuf:"varint,3,opt,name=lose,proto3" json:"lose,omitempty"`
+	SecretWord string                 `protobuf:"bytes,4,opt,name=secret_word,json=secretWord,proto3" json:"secret_word,omitempty"`
+	Messages   []string               `protobuf:"bytes,5,rep,name=messages,proto3" json:"messages,omitempty"`
+	State      *GameRound             `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	// Set instead of the other fields when a streamed guess is rejected without
+	// consuming an attempt.
+	Errors        []string `protobuf:"bytes,7,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuessResponse) Reset() {
+	*x = GuessResponse{}
+	mi := &file_gwordle_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuessResponse) ProtoMessage() {}
+
+func (x *GuessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gwordle_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuessResponse.ProtoReflect.Descriptor instead.
+func (*GuessResponse) Descriptor() ([]byte, []int) {
+	return file_gwordle_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GuessResponse) GetResult() *ValidationResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *GuessResponse) GetWin() bool {
+	if x != nil {
+		return x.Win
+	}
+	return false
+}
+
+func (x *GuessResponse) GetLose() bool {
+	if x != nil {
+		return x.Lose
+	}
+	return false
+}
+
+func (x *GuessResponse) GetSecretWord() string {
+	if x != nil {
+		return x.SecretWord
+	}
+	return ""
+}
+
+func (x *GuessResponse) GetMessages() []string {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *GuessResponse) GetState() *GameRound {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *GuessResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type GetStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStateRequest) Reset() {
+	*x = GetStateRequest{}
+	mi := &file_gwordle_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStateRequest) ProtoMessage() {}
+
+func (x *GetStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gwordle_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStateRequest.ProtoReflect.Descriptor instead.
+func (*GetStateRequest) Descriptor() ([]byte, []int) {
+	return file_gwordle_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetStateRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type ForfeitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForfeitRequest) Reset() {
+	*x = ForfeitRequest{}
+	mi := &file_gwordle_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForfeitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForfeitRequest) ProtoMessage() {}
+
+func (x *ForfeitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gwordle_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForfeitRequest.ProtoReflect.Descriptor instead.
+func (*ForfeitRequest) Descriptor() ([]byte, []int) {
+	return file_gwordle_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ForfeitRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type GetStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatsRequest) Reset() {
+	*x = GetStatsRequest{}
+	mi := &file_gwordle_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsRequest) ProtoMessage() {}
+
+func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gwordle_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetStatsRequest) Descriptor() ([]byte, []int) {
+	return file_gwordle_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetStatsRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type Stats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Wins          int32                  `protobuf:"varint,1,opt,name=wins,proto3" json:"wins,omitempty"`
+	Losses        int32                  `protobuf:"varint,2,opt,name=losses,proto3" json:"losses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Stats) Reset() {
+	*x = Stats{}
+	mi := &file_gwordle_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Stats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stats) ProtoMessage() {}
+
+func (x *Stats) ProtoReflect() protoreflect.Message {
+	mi := &file_gwordle_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stats.ProtoReflect.Descriptor instead.
+func (*Stats) Descriptor() ([]byte, []int) {
+	return file_gwordle_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Stats) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *Stats) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+var File_gwordle_proto protoreflect.FileDescriptor
+
+const file_gwordle_proto_rawDesc = "" +
+	"\n" +
+	"\rgwordle.proto\x12\n" +
+	"gwordle.v1\"d\n" +
+	"\x14CharValidationResult\x12\x12\n" +
+	"\x04char\x18\x01 \x01(\tR\x04char\x128\n" +
+	"\x06status\x18\x02 \x01(\x0e2 .gwordle.v1.CharValidationStatusR\x06status\"`\n" +
+	"\x10ValidationResult\x12\x14\n" +
+	"\x05match\x18\x01 \x01(\bR\x05match\x126\n" +
+	"\x05chars\x18\x02 \x03(\v2 .gwordle.v1.CharValidationResultR\x05chars\"\xe6\x01\n" +
+	"\tGameRound\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1b\n" +
+	"\thard_mode\x18\x02 \x01(\bR\bhardMode\x12\x1f\n" +
+	"\vword_length\x18\x03 \x01(\x05R\n" +
+	"wordLength\x12-\n" +
+	"\x12remaining_attempts\x18\x04 \x01(\x05R\x11remainingAttempts\x126\n" +
+	"\aresults\x18\x05 \x03(\v2\x1c.gwordle.v1.ValidationResultR\aresults\x12\x1b\n" +
+	"\tmax_tries\x18\x06 \x01(\x05R\bmaxTries\"k\n" +
+	"\x0eNewGameRequest\x12\x1b\n" +
+	"\thard_mode\x18\x01 \x01(\bR\bhardMode\x12\x1f\n" +
+	"\vword_length\x18\x02 \x01(\x05R\n" +
+	"wordLength\x12\x1b\n" +
+	"\tmax_tries\x18\x03 \x01(\x05R\bmaxTries\";\n" +
+	"\fGuessRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x12\n" +
+	"\x04word\x18\x02 \x01(\tR\x04word\"\xed\x01\n" +
+	"\rGuessResponse\x124\n" +
+	"\x06result\x18\x01 \x01(\v2\x1c.gwordle.v1.ValidationResultR\x06result\x12\x10\n" +
+	"\x03win\x18\x02 \x01(\bR\x03win\x12\x12\n" +
+	"\x04lose\x18\x03 \x01(\bR\x04lose\x12\x1f\n" +
+	"\vsecret_word\x18\x04 \x01(\tR\n" +
+	"secretWord\x12\x1a\n" +
+	"\bmessages\x18\x05 \x03(\tR\bmessages\x12+\n" +
+	"\x05state\x18\x06 \x01(\v2\x15.gwordle.v1.GameRoundR\x05state\x12\x16\n" +
+	"\x06errors\x18\a \x03(\tR\x06errors\"*\n" +
+	"\x0fGetStateRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\")\n" +
+	"\x0eForfeitRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\"*\n" +
+	"\x0fGetStatsRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\"3\n" +
+	"\x05Stats\x12\x12\n" +
+	"\x04wins\x18\x01 \x01(\x05R\x04wins\x12\x16\n" +
+	"\x06losses\x18\x02 \x01(\x05R\x06losses*\x7f\n" +
+	"\x14CharValidationStatus\x12&\n" +
+	"\"CHAR_VALIDATION_STATUS_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eVALID_POSITION\x10\x01\x12\x14\n" +
+	"\x10INVALID_POSITION\x10\x02\x12\x15\n" +
+	"\x11INVALID_CHARACTER\x10\x032\x84\x03\n" +
+	"\aGwordle\x12<\n" +
+	"\aNewGame\x12\x1a.gwordle.v1.NewGameRequest\x1a\x15.gwordle.v1.GameRound\x12<\n" +
+	"\x05Guess\x12\x18.gwordle.v1.GuessRequest\x1a\x19.gwordle.v1.GuessResponse\x12>\n" +
+	"\bGetState\x12\x1b.gwordle.v1.GetStateRequest\x1a\x15.gwordle.v1.GameRound\x12@\n" +
+	"\aForfeit\x12\x1a.gwordle.v1.ForfeitRequest\x1a\x19.gwordle.v1.GuessResponse\x12:\n" +
+	"\bGetStats\x12\x1b.gwordle.v1.GetStatsRequest\x1a\x11.gwordle.v1.Stats\x12?\n" +
+	"\x04Play\x12\x18.gwordle.v1.GuessRequest\x1a\x19.gwordle.v1.GuessResponse(\x010\x01B8Z6github.com/tanmancan/gwordle/v1/internal/rpc/gwordlepbb\x06proto3"
+
+var (
+	file_gwordle_proto_rawDescOnce sync.Once
+	file_gwordle_proto_rawDescData []byte
+)
+
+func file_gwordle_proto_rawDescGZIP() []byte {
+	file_gwordle_proto_rawDescOnce.Do(func() {
+		file_gwordle_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_gwordle_proto_rawDesc), len(file_gwordle_proto_rawDesc)))
+	})
+	return file_gwordle_proto_rawDescData
+}
+
+var file_gwordle_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_gwordle_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_gwordle_proto_goTypes = []any{
+	(CharValidationStatus)(0),    // 0: gwordle.v1.CharValidationStatus
+	(*CharValidationResult)(nil), // 1: gwordle.v1.CharValidationResult
+	(*ValidationResult)(nil),     // 2: gwordle.v1.ValidationResult
+	(*GameRound)(nil),            // 3: gwordle.v1.GameRound
+	(*NewGameRequest)(nil),       // 4: gwordle.v1.NewGameRequest
+	(*GuessRequest)(nil),         // 5: gwordle.v1.GuessRequest
+	(*GuessResponse)(nil),        // 6: gwordle.v1.GuessResponse
+	(*GetStateRequest)(nil),      // 7: gwordle.v1.GetStateRequest
+	(*ForfeitRequest)(nil),       // 8: gwordle.v1.ForfeitRequest
+	(*GetStatsRequest)(nil),      // 9: gwordle.v1.GetStatsRequest
+	(*Stats)(nil),                // 10: gwordle.v1.Stats
+}
+var file_gwordle_proto_depIdxs = []int32{
+	0,  // 0: gwordle.v1.CharValidationResult.status:type_name -> gwordle.v1.CharValidationStatus
+	1,  // 1: gwordle.v1.ValidationResult.chars:type_name -> gwordle.v1.CharValidationResult
+	2,  // 2: gwordle.v1.GameRound.results:type_name -> gwordle.v1.ValidationResult
+	2,  // 3: gwordle.v1.GuessResponse.result:type_name -> gwordle.v1.ValidationResult
+	3,  // 4: gwordle.v1.GuessResponse.state:type_name -> gwordle.v1.GameRound
+	4,  // 5: gwordle.v1.Gwordle.NewGame:input_type -> gwordle.v1.NewGameRequest
+	5,  // 6: gwordle.v1.Gwordle.Guess:input_type -> gwordle.v1.GuessRequest
+	7,  // 7: gwordle.v1.Gwordle.GetState:input_type -> gwordle.v1.GetStateRequest
+	8,  // 8: gwordle.v1.Gwordle.Forfeit:input_type -> gwordle.v1.ForfeitRequest
+	9,  // 9: gwordle.v1.Gwordle.GetStats:input_type -> gwordle.v1.GetStatsRequest
+	5,  // 10: gwordle.v1.Gwordle.Play:input_type -> gwordle.v1.GuessRequest
+	3,  // 11: gwordle.v1.Gwordle.NewGame:output_type -> gwordle.v1.GameRound
+	6,  // 12: gwordle.v1.Gwordle.Guess:output_type -> gwordle.v1.GuessResponse
+	3,  // 13: gwordle.v1.Gwordle.GetState:output_type -> gwordle.v1.GameRound
+	6,  // 14: gwordle.v1.Gwordle.Forfeit:output_type -> gwordle.v1.GuessResponse
+	10, // 15: gwordle.v1.Gwordle.GetStats:output_type -> gwordle.v1.Stats
+	6,  // 16: gwordle.v1.Gwordle.Play:output_type -> gwordle.v1.GuessResponse
+	11, // [11:17] is the sub-list for method output_type
+	5,  // [5:11] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_gwordle_proto_init() }
+func file_gwordle_proto_init() {
+	if File_gwordle_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gwordle_proto_rawDesc), len(file_gwordle_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_gwordle_proto_goTypes,
+		DependencyIndexes: file_gwordle_proto_depIdxs,
+		EnumInfos:         file_gwordle_proto_enumTypes,
+		MessageInfos:      file_gwordle_proto_msgTypes,
+	}.Build()
+	File_gwordle_proto = out.File
+	file_gwordle_proto_goTypes = nil
+	file_gwordle_proto_depIdxs = nil
+}
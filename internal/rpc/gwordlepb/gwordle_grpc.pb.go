@@ -0,0 +1,324 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: gwordle.proto
+
+package gwordlepb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Gwordle_NewGame_FullMethodName  = "/gwordle.v1.Gwordle/NewGame"
+	Gwordle_Guess_FullMethodName    = "/gwordle.v1.Gwordle/Guess"
+	Gwordle_GetState_FullMethodName = "/gwordle.v1.Gwordle/GetState"
+	Gwordle_Forfeit_FullMethodName  = "/gwordle.v1.Gwordle/Forfeit"
+	Gwordle_GetStats_FullMethodName = "/gwordle.v1.Gwordle/GetStats"
+	Gwordle_Play_FullMethodName     = "/gwordle.v1.Gwordle/Play"
+)
+
+// GwordleClient is the client API for Gwordle service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Game lifecycle for gwordle. Every game is identified by an ID and keeps
+// playing new rounds until the server stops.
+type GwordleClient interface {
+	// Create a game and start its first round.
+	NewGame(ctx context.Context, in *NewGameRequest, opts ...grpc.CallOption) (*GameRound, error)
+	// Submit a guess word for the current round.
+	Guess(ctx context.Context, in *GuessRequest, opts ...grpc.CallOption) (*GuessResponse, error)
+	// Get the current round.
+	GetState(ctx context.Context, in *GetStateRequest, opts ...grpc.CallOption) (*GameRound, error)
+	// Forfeit the current round and start a new one.
+	Forfeit(ctx context.Context, in *ForfeitRequest, opts ...grpc.CallOption) (*GuessResponse, error)
+	// Get the total number of wins and losses.
+	GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*Stats, error)
+	// Submit a stream of guess words and receive the outcome of each one.
+	Play(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[GuessRequest, GuessResponse], error)
+}
+
+type gwordleClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGwordleClient(cc grpc.ClientConnInterface) GwordleClient {
+	return &gwordleClient{cc}
+}
+
+func (c *gwordleClient) NewGame(ctx context.Context, in *NewGameRequest, opts ...grpc.CallOption) (*GameRound, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameRound)
+	err := c.cc.Invoke(ctx, Gwordle_NewGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gwordleClient) Guess(ctx context.Context, in *GuessRequest, opts ...grpc.CallOption) (*GuessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GuessResponse)
+	err := c.cc.Invoke(ctx, Gwordle_Guess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gwordleClient) GetState(ctx context.Context, in *GetStateRequest, opts ...grpc.CallOption) (*GameRound, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameRound)
+	err := c.cc.Invoke(ctx, Gwordle_GetState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gwordleClient) Forfeit(ctx context.Context, in *ForfeitRequest, opts ...grpc.CallOption) (*GuessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GuessResponse)
+	err := c.cc.Invoke(ctx, Gwordle_Forfeit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gwordleClient) GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*Stats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Stats)
+	err := c.cc.Invoke(ctx, Gwordle_GetStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gwordleClient) Play(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[GuessRequest, GuessResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Gwordle_ServiceDesc.Streams[0], Gwordle_Play_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GuessRequest, GuessResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Gwordle_PlayClient = grpc.BidiStreamingClient[GuessRequest, GuessResponse]
+
+// GwordleServer is the server API for Gwordle service.
+// All implementations must embed UnimplementedGwordleServer
+// for forward compatibility.
+//
+// Game lifecycle for gwordle. Every game is identified by an ID and keeps
+// playing new rounds until the server stops.
+type GwordleServer interface {
+	// Create a game and start its first round.
+	NewGame(context.Context, *NewGameRequest) (*GameRound, error)
+	// Submit a guess word for the current round.
+	Guess(context.Context, *GuessRequest) (*GuessResponse, error)
+	// Get the current round.
+	GetState(context.Context, *GetStateRequest) (*GameRound, error)
+	// Forfeit the current round and start a new one.
+	Forfeit(context.Context, *ForfeitRequest) (*GuessResponse, error)
+	// Get the total number of wins and losses.
+	GetStats(context.Context, *GetStatsRequest) (*Stats, error)
+	// Submit a stream of guess words and receive the outcome of each one.
+	Play(grpc.BidiStreamingServer[GuessRequest, GuessResponse]) error
+	mustEmbedUnimplementedGwordleServer()
+}
+
+// UnimplementedGwordleServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGwordleServer struct{}
+
+func (UnimplementedGwordleServer) NewGame(context.Context, *NewGameRequest) (*GameRound, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewGame not implemented")
+}
+func (UnimplementedGwordleServer) Guess(context.Context, *GuessRequest) (*GuessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Guess not implemented")
+}
+func (UnimplementedGwordleServer) GetState(context.Context, *GetStateRequest) (*GameRound, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetState not implemented")
+}
+func (UnimplementedGwordleServer) Forfeit(context.Context, *ForfeitRequest) (*GuessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Forfeit not implemented")
+}
+func (UnimplementedGwordleServer) GetStats(context.Context, *GetStatsRequest) (*Stats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
+}
+func (UnimplementedGwordleServer) Play(grpc.BidiStreamingServer[GuessRequest, GuessResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Play not implemented")
+}
+func (UnimplementedGwordleServer) mustEmbedUnimplementedGwordleServer() {}
+func (UnimplementedGwordleServer) testEmbeddedByValue()                 {}
+
+// UnsafeGwordleServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GwordleServer will
+// result in compilation errors.
+type UnsafeGwordleServer interface {
+	mustEmbedUnimplementedGwordleServer()
+}
+
+func RegisterGwordleServer(s grpc.ServiceRegistrar, srv GwordleServer) {
+	// If the following call pancis, it indicates UnimplementedGwordleServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Gwordle_ServiceDesc, srv)
+}
+
+func _Gwordle_NewGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GwordleServer).NewGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gwordle_NewGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GwordleServer).NewGame(ctx, req.(*NewGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gwordle_Guess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GwordleServer).Guess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gwordle_Guess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GwordleServer).Guess(ctx, req.(*GuessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gwordle_GetState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GwordleServer).GetState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gwordle_GetState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GwordleServer).GetState(ctx, req.(*GetStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gwordle_Forfeit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForfeitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GwordleServer).Forfeit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gwordle_Forfeit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GwordleServer).Forfeit(ctx, req.(*ForfeitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gwordle_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GwordleServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gwordle_GetStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GwordleServer).GetStats(ctx, req.(*GetStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gwordle_Play_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GwordleServer).Play(&grpc.GenericServerStream[GuessRequest, GuessResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Gwordle_PlayServer = grpc.BidiStreamingServer[GuessRequest, GuessResponse]
+
+// Gwordle_ServiceDesc is the grpc.ServiceDesc for Gwordle service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Gwordle_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gwordle.v1.Gwordle",
+	HandlerType: (*GwordleServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "NewGame",
+			Handler:    _Gwordle_NewGame_Handler,
+		},
+		{
+			MethodName: "Guess",
+			Handler:    _Gwordle_Guess_Handler,
+		},
+		{
+			MethodName: "GetState",
+			Handler:    _Gwordle_GetState_Handler,
+		},
+		{
+			MethodName: "Forfeit",
+			Handler:    _Gwordle_Forfeit_Handler,
+		},
+		{
+			MethodName: "GetStats",
+			Handler:    _Gwordle_GetStats_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Play",
+			Handler:       _Gwordle_Play_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "gwordle.proto",
+}
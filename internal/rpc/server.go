@@ -0,0 +1,212 @@
+// Package rpc exposes the game engine as a gRPC service and provides a thin client for it.
+package rpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/tanmancan/gwordle/v1/internal/rpc/gwordlepb"
+	"github.com/tanmancan/gwordle/v1/internal/server"
+	"github.com/tanmancan/gwordle/v1/internal/wengine"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// gRPC implementation of the gwordle service, backed by the same GameStore as the REST API.
+type GameServer struct {
+	gwordlepb.UnimplementedGwordleServer
+	Store *server.GameStore
+}
+
+// Create a gRPC game server backed by the given store.
+func NewGameServer(store *server.GameStore) *GameServer {
+	return &GameServer{
+		Store: store,
+	}
+}
+
+// Maps a wengine.CharValidationStatus to its protobuf enum.
+func StatusToProto(s wengine.CharValidationStatus) gwordlepb.CharValidationStatus {
+	switch s {
+	case wengine.ValidPosition:
+		return gwordlepb.CharValidationStatus_VALID_POSITION
+	case wengine.InvalidPosition:
+		return gwordlepb.CharValidationStatus_INVALID_POSITION
+	case wengine.InvalidCharacter:
+		return gwordlepb.CharValidationStatus_INVALID_CHARACTER
+	default:
+		return gwordlepb.CharValidationStatus_CHAR_VALIDATION_STATUS_UNSPECIFIED
+	}
+}
+
+// Maps a protobuf enum to its wengine.CharValidationStatus.
+func StatusFromProto(s gwordlepb.CharValidationStatus) wengine.CharValidationStatus {
+	switch s {
+	case gwordlepb.CharValidationStatus_VALID_POSITION:
+		return wengine.ValidPosition
+	case gwordlepb.CharValidationStatus_INVALID_POSITION:
+		return wengine.InvalidPosition
+	default:
+		return wengine.InvalidCharacter
+	}
+}
+
+// Maps a wengine.ValidationResult to its protobuf message.
+func ResultToProto(result wengine.ValidationResult) *gwordlepb.ValidationResult {
+	pb := &gwordlepb.ValidationResult{
+		Match: result.Match,
+	}
+	for _, c := range result.Chars {
+		pb.Chars = append(pb.Chars, &gwordlepb.CharValidationResult{
+			Char: c.Char,
+			Status: StatusToProto(c.Status),
+		})
+	}
+	return pb
+}
+
+// Maps a protobuf message to its wengine.ValidationResult.
+func ResultFromProto(pb *gwordlepb.ValidationResult) wengine.ValidationResult {
+	result := wengine.ValidationResult{
+		Match: pb.GetMatch(),
+	}
+	for _, c := range pb.GetChars() {
+		result.Chars = append(result.Chars, wengine.CharValidationResult{
+			Char: c.GetChar(),
+			Status: StatusFromProto(c.GetStatus()),
+		})
+	}
+	return result
+}
+
+// Maps a game on the server to its protobuf message, without the secret word.
+func roundToProto(game server.GameSnapshot) *gwordlepb.GameRound {
+	pb := &gwordlepb.GameRound{
+		GameId: game.ID,
+		HardMode: game.HardMode,
+		WordLength: int32(game.Round.GetWordLength()),
+		RemainingAttempts: int32(game.Round.RemainingAttempts),
+		MaxTries: int32(game.Round.GetMaxTries()),
+	}
+	for _, result := range game.Round.Results {
+		pb.Results = append(pb.Results, ResultToProto(result))
+	}
+	return pb
+}
+
+func outcomeToProto(outcome server.RoundOutcome) *gwordlepb.GuessResponse {
+	pb := &gwordlepb.GuessResponse{
+		Win: outcome.Win,
+		Lose: outcome.Lose,
+		SecretWord: outcome.SecretWord,
+		Messages: outcome.Messages,
+		State: roundToProto(outcome.Game),
+	}
+	if outcome.Result != nil {
+		pb.Result = ResultToProto(*outcome.Result)
+	}
+	return pb
+}
+
+// Maps errors returned by the GameStore to a gRPC status.
+func storeError(err error) error {
+	var invalidGuess *server.InvalidGuessError
+	var invalidRules *server.InvalidRulesError
+	switch {
+	case errors.Is(err, server.ErrGameNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.As(err, &invalidRules):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.As(err, &invalidGuess):
+		return status.Error(codes.InvalidArgument, strings.Join(invalidGuess.Messages, "\n"))
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// Create a game and start its first round.
+func (s *GameServer) NewGame(ctx context.Context, req *gwordlepb.NewGameRequest) (*gwordlepb.GameRound, error) {
+	game, err := s.Store.NewGame(server.GameRules{
+		HardMode: req.GetHardMode(),
+		WordLength: int(req.GetWordLength()),
+		MaxTries: int(req.GetMaxTries()),
+	})
+	if err != nil {
+		return nil, storeError(err)
+	}
+	return roundToProto(game), nil
+}
+
+// Submit a guess word for the current round.
+func (s *GameServer) Guess(ctx context.Context, req *gwordlepb.GuessRequest) (*gwordlepb.GuessResponse, error) {
+	if strings.TrimSpace(req.GetWord()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "word is required")
+	}
+	outcome, err := s.Store.Guess(req.GetGameId(), req.GetWord())
+	if err != nil {
+		return nil, storeError(err)
+	}
+	return outcomeToProto(outcome), nil
+}
+
+// Get the current round.
+func (s *GameServer) GetState(ctx context.Context, req *gwordlepb.GetStateRequest) (*gwordlepb.GameRound, error) {
+	game, err := s.Store.State(req.GetGameId())
+	if err != nil {
+		return nil, storeError(err)
+	}
+	return roundToProto(game), nil
+}
+
+// Forfeit the current round and start a new one.
+func (s *GameServer) Forfeit(ctx context.Context, req *gwordlepb.ForfeitRequest) (*gwordlepb.GuessResponse, error) {
+	outcome, err := s.Store.Forfeit(req.GetGameId())
+	if err != nil {
+		return nil, storeError(err)
+	}
+	return outcomeToProto(outcome), nil
+}
+
+// Get the total number of wins and losses.
+func (s *GameServer) GetStats(ctx context.Context, req *gwordlepb.GetStatsRequest) (*gwordlepb.Stats, error) {
+	win, loss, err := s.Store.Score(req.GetGameId())
+	if err != nil {
+		return nil, storeError(err)
+	}
+	return &gwordlepb.Stats{
+		Wins: int32(win),
+		Losses: int32(loss),
+	}, nil
+}
+
+// Submit a stream of guess words and receive the outcome of each one.
+// Rejected guesses are reported in GuessResponse.Errors and do not end the stream.
+func (s *GameServer) Play(stream gwordlepb.Gwordle_PlayServer) error {
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		outcome, err := s.Store.Guess(req.GetGameId(), req.GetWord())
+		var invalidGuess *server.InvalidGuessError
+		switch {
+		case errors.As(err, &invalidGuess):
+			err = stream.Send(&gwordlepb.GuessResponse{
+				Errors: invalidGuess.Messages,
+			})
+		case err != nil:
+			return storeError(err)
+		default:
+			err = stream.Send(outcomeToProto(outcome))
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
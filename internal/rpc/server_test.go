@@ -0,0 +1,192 @@
+package rpc
+
+import (
+	"context"
+	"io"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/tanmancan/gwordle/v1/internal/rpc/gwordlepb"
+	"github.com/tanmancan/gwordle/v1/internal/server"
+	"github.com/tanmancan/gwordle/v1/internal/server/servertest"
+	"github.com/tanmancan/gwordle/v1/internal/wengine"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// Start the service on an in-memory listener, without a dictionary, and connect a client to it.
+func newTestClient(t *testing.T) (*Client, *server.GameStore) {
+	t.Helper()
+	servertest.UseNoDictionary(t)
+
+	lis := bufconn.Listen(1024 * 1024)
+	store := server.NewGameStore()
+	srv := grpc.NewServer()
+	gwordlepb.RegisterGwordleServer(srv, NewGameServer(store))
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	client, err := Dial("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return client, store
+}
+
+func TestResultToProto(t *testing.T) {
+	result, _ := wengine.ValidateWord("stare", "poise")
+	pb := ResultToProto(result)
+	want := []gwordlepb.CharValidationStatus{
+		gwordlepb.CharValidationStatus_INVALID_POSITION,
+		gwordlepb.CharValidationStatus_INVALID_CHARACTER,
+		gwordlepb.CharValidationStatus_INVALID_CHARACTER,
+		gwordlepb.CharValidationStatus_INVALID_CHARACTER,
+		gwordlepb.CharValidationStatus_VALID_POSITION,
+	}
+	var got []gwordlepb.CharValidationStatus
+	for _, c := range pb.GetChars() {
+		got = append(got, c.GetStatus())
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ResultToProto() statuses = %v, want %v", got, want)
+	}
+	if back := ResultFromProto(pb); !reflect.DeepEqual(back, result) {
+		t.Errorf("ResultFromProto(ResultToProto()) = %+v, want %+v", back, result)
+	}
+}
+
+func TestGameServer(t *testing.T) {
+	client, store := newTestClient(t)
+	ctx := context.Background()
+	id, secretWord := servertest.NewGame(t, store)
+
+	tests := []struct {
+		name     string
+		call     func() (interface{}, error)
+		wantCode codes.Code
+	}{
+		{
+			name: "New game",
+			call: func() (interface{}, error) {
+				return client.NewGame(ctx, server.GameRules{WordLength: 5, MaxTries: 6})
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "Invalid rules",
+			call: func() (interface{}, error) {
+				return client.NewGame(ctx, server.GameRules{WordLength: 5, MaxTries: -1})
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "Invalid word",
+			call: func() (interface{}, error) {
+				return client.Guess(ctx, id, "zzzzz")
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "Unknown game",
+			call: func() (interface{}, error) {
+				return client.GetState(ctx, "unknown")
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "Valid word",
+			call: func() (interface{}, error) {
+				return client.Guess(ctx, id, servertest.OtherWord(secretWord))
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "State",
+			call: func() (interface{}, error) {
+				return client.GetState(ctx, id)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "Forfeit",
+			call: func() (interface{}, error) {
+				return client.Forfeit(ctx, id)
+			},
+			wantCode: codes.OK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.call()
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("%s error = %v, want code %v", tt.name, err, tt.wantCode)
+			}
+		})
+	}
+
+	stats, err := client.GetStats(ctx, id)
+	if err != nil {
+		t.Fatalf("GetStats() error = %v", err)
+	}
+	if stats.GetWins() != 0 || stats.GetLosses() != 1 {
+		t.Errorf("GetStats() = %v, want 0 wins and 1 loss", stats)
+	}
+}
+
+func TestGameServer_Play(t *testing.T) {
+	client, store := newTestClient(t)
+	ctx := context.Background()
+	id, secretWord := servertest.NewGame(t, store)
+
+	stream, err := client.API().Play(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	words := []string{"zzzzz", servertest.OtherWord(secretWord), secretWord}
+	for _, word := range words {
+		if err := stream.Send(&gwordlepb.GuessRequest{GameId: id, Word: word}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		t.Fatal(err)
+	}
+
+	var responses []*gwordlepb.GuessResponse
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Recv() error = %v", err)
+		}
+		responses = append(responses, resp)
+	}
+	if len(responses) != len(words) {
+		t.Fatalf("Play() sent %d responses, want %d", len(responses), len(words))
+	}
+	if len(responses[0].GetErrors()) == 0 || responses[0].GetState() != nil {
+		t.Errorf("Play() response to an invalid word = %v, want only errors", responses[0])
+	}
+	if got := responses[1].GetState().GetRemainingAttempts(); got != 5 || responses[1].GetResult() == nil {
+		t.Errorf("Play() response to a valid word = %v, want a result and 5 attempts left", responses[1])
+	}
+	last := responses[2]
+	if !last.GetWin() || last.GetSecretWord() != secretWord || !last.GetResult().GetMatch() {
+		t.Errorf("Play() response to the secret word = %v, want a win", last)
+	}
+	if got := last.GetState(); got.GetRemainingAttempts() != 6 || len(got.GetResults()) != 0 {
+		t.Errorf("Play() state after a win = %v, want a new round", got)
+	}
+}
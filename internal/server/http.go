@@ -0,0 +1,261 @@
+// Package server exposes the game engine over HTTP/JSON.
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/tanmancan/gwordle/v1/internal/wengine"
+)
+
+// Request body for creating a game.
+// Zero WordLength and MaxTries use the server defaults.
+type NewGameRequest struct {
+	HardMode bool `json:"hardMode"`
+	WordLength int `json:"wordLength,omitempty"`
+	MaxTries int `json:"maxTries,omitempty"`
+}
+
+// Request body for submitting a guess.
+type GuessRequest struct {
+	Word string `json:"word"`
+}
+
+// Validation result of a single character.
+type CharResultResponse struct {
+	Char string `json:"char"`
+	Status string `json:"status"`
+}
+
+// Validation result of a guess word.
+type ResultResponse struct {
+	Match bool `json:"match"`
+	Chars []CharResultResponse `json:"chars"`
+}
+
+// The current round of a game. The secret word is never included.
+type StateResponse struct {
+	ID string `json:"id"`
+	HardMode bool `json:"hardMode"`
+	WordLength int `json:"wordLength"`
+	RemainingAttempts int `json:"remainingAttempts"`
+	MaxTries int `json:"maxTries"`
+	Results []ResultResponse `json:"results"`
+}
+
+// Outcome of a guess or a forfeit.
+// SecretWord is only set once the round has ended. A new round is started automatically.
+type RoundResponse struct {
+	Result *ResultResponse `json:"result,omitempty"`
+	Win bool `json:"win"`
+	Lose bool `json:"lose"`
+	SecretWord string `json:"secretWord,omitempty"`
+	Messages []string `json:"messages,omitempty"`
+	State StateResponse `json:"state"`
+}
+
+// Total number of wins and losses for a game.
+type ScoreResponse struct {
+	Wins int `json:"wins"`
+	Losses int `json:"losses"`
+}
+
+// Error response body.
+type ErrorResponse struct {
+	Error string `json:"error"`
+	Messages []string `json:"messages,omitempty"`
+}
+
+func newResultResponse(result wengine.ValidationResult) ResultResponse {
+	response := ResultResponse{
+		Match: result.Match,
+		Chars: []CharResultResponse{},
+	}
+	for _, c := range result.Chars {
+		response.Chars = append(response.Chars, CharResultResponse{
+			Char: c.Char,
+			Status: c.Status,
+		})
+	}
+	return response
+}
+
+func newStateResponse(game GameSnapshot) StateResponse {
+	response := StateResponse{
+		ID: game.ID,
+		HardMode: game.HardMode,
+		WordLength: game.Round.GetWordLength(),
+		RemainingAttempts: game.Round.RemainingAttempts,
+		MaxTries: game.Round.GetMaxTries(),
+		Results: []ResultResponse{},
+	}
+	for _, result := range game.Round.Results {
+		response.Results = append(response.Results, newResultResponse(result))
+	}
+	return response
+}
+
+func newRoundResponse(outcome RoundOutcome) RoundResponse {
+	response := RoundResponse{
+		Win: outcome.Win,
+		Lose: outcome.Lose,
+		SecretWord: outcome.SecretWord,
+		Messages: outcome.Messages,
+		State: newStateResponse(outcome.Game),
+	}
+	if outcome.Result != nil {
+		result := newResultResponse(*outcome.Result)
+		response.Result = &result
+	}
+	return response
+}
+
+// HTTP/JSON API for the game engine.
+type Server struct {
+	Store *GameStore
+}
+
+// Create a server with an empty game store.
+func NewServer() *Server {
+	return &Server{
+		Store: NewGameStore(),
+	}
+}
+
+// Routes the request to the matching handler.
+//
+//	POST /games               Create a game.
+//	GET  /games/{id}          Get the current round.
+//	POST /games/{id}/guesses  Submit a guess word.
+//	POST /games/{id}/forfeit  Forfeit the current round.
+//	GET  /games/{id}/score    Get the total wins and losses.
+func (srv *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	path := strings.Trim(req.URL.Path, "/")
+	parts := strings.Split(path, "/")
+
+	if parts[0] != "games" || len(parts) > 3 {
+		writeError(w, http.StatusNotFound, "not found", nil)
+		return
+	}
+
+	switch {
+	case len(parts) == 1 && req.Method == http.MethodPost:
+		srv.handleNewGame(w, req)
+	case len(parts) == 2 && req.Method == http.MethodGet:
+		srv.handleGetState(w, parts[1])
+	case len(parts) == 3 && parts[2] == "guesses" && req.Method == http.MethodPost:
+		srv.handleGuess(w, req, parts[1])
+	case len(parts) == 3 && parts[2] == "forfeit" && req.Method == http.MethodPost:
+		srv.handleForfeit(w, parts[1])
+	case len(parts) == 3 && parts[2] == "score" && req.Method == http.MethodGet:
+		srv.handleGetScore(w, parts[1])
+	default:
+		writeError(w, http.StatusNotFound, "not found", nil)
+	}
+}
+
+func (srv *Server) handleNewGame(w http.ResponseWriter, req *http.Request) {
+	var body NewGameRequest
+	if req.ContentLength != 0 {
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error(), nil)
+			return
+		}
+	}
+
+	game, err := srv.Store.NewGame(GameRules{
+		HardMode: body.HardMode,
+		WordLength: body.WordLength,
+		MaxTries: body.MaxTries,
+	})
+	if err != nil {
+		writeStoreError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, newStateResponse(game))
+}
+
+func (srv *Server) handleGetState(w http.ResponseWriter, id string) {
+	game, err := srv.Store.State(id)
+	if err != nil {
+		writeStoreError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newStateResponse(game))
+}
+
+func (srv *Server) handleGuess(w http.ResponseWriter, req *http.Request, id string) {
+	var body GuessRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	if strings.TrimSpace(body.Word) == "" {
+		writeError(w, http.StatusBadRequest, "word is required", nil)
+		return
+	}
+
+	outcome, err := srv.Store.Guess(id, body.Word)
+	if err != nil {
+		writeStoreError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newRoundResponse(outcome))
+}
+
+func (srv *Server) handleForfeit(w http.ResponseWriter, id string) {
+	outcome, err := srv.Store.Forfeit(id)
+	if err != nil {
+		writeStoreError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newRoundResponse(outcome))
+}
+
+func (srv *Server) handleGetScore(w http.ResponseWriter, id string) {
+	win, loss, err := srv.Store.Score(id)
+	if err != nil {
+		writeStoreError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, ScoreResponse{
+		Wins: win,
+		Losses: loss,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, msg string, messages []string) {
+	writeJSON(w, status, ErrorResponse{
+		Error: msg,
+		Messages: messages,
+	})
+}
+
+// Maps errors returned by the GameStore to an error response.
+func writeStoreError(w http.ResponseWriter, err error) {
+	var invalidGuess *InvalidGuessError
+	var invalidRules *InvalidRulesError
+	switch {
+	case errors.Is(err, ErrGameNotFound):
+		writeError(w, http.StatusNotFound, err.Error(), nil)
+	case errors.As(err, &invalidRules):
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+	case errors.As(err, &invalidGuess):
+		writeError(w, http.StatusUnprocessableEntity, "invalid guess", invalidGuess.Messages)
+	default:
+		writeError(w, http.StatusInternalServerError, err.Error(), nil)
+	}
+}
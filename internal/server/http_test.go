@@ -0,0 +1,253 @@
+package server_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tanmancan/gwordle/v1/internal/server"
+	"github.com/tanmancan/gwordle/v1/internal/server/servertest"
+	"github.com/tanmancan/gwordle/v1/internal/wengine"
+)
+
+// Create a test server without a dictionary, so only words from the word list are accepted.
+func newTestServer(t *testing.T) (*server.Server, *httptest.Server) {
+	t.Helper()
+	servertest.UseNoDictionary(t)
+
+	srv := server.NewServer()
+	ts := httptest.NewServer(srv)
+	t.Cleanup(ts.Close)
+	return srv, ts
+}
+
+// Send a request and decode the JSON response into v. Returns the status code.
+func doJSON(t *testing.T, method string, url string, body string, v interface{}) int {
+	t.Helper()
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		t.Fatalf("%s %s: decoding response: %v", method, url, err)
+	}
+	return resp.StatusCode
+}
+
+func TestServer_newGame(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantState  server.StateResponse
+	}{
+		{
+			name:       "Rules",
+			body:       `{"hardMode": true, "wordLength": 6, "maxTries": 4}`,
+			wantStatus: http.StatusCreated,
+			wantState:  server.StateResponse{HardMode: true, WordLength: 6, RemainingAttempts: 4, MaxTries: 4},
+		},
+		{
+			name:       "Invalid maximum number of tries",
+			body:       `{"wordLength": 5, "maxTries": -1}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "Not enough words",
+			body:       `{"wordLength": 40, "maxTries": 6}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "Invalid body",
+			body:       `{`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, ts := newTestServer(t)
+			var state server.StateResponse
+			status := doJSON(t, http.MethodPost, ts.URL+"/games", tt.body, &state)
+			if status != tt.wantStatus {
+				t.Fatalf("POST /games = %d, want %d", status, tt.wantStatus)
+			}
+			if status != http.StatusCreated {
+				return
+			}
+			if state.ID == "" {
+				t.Errorf("POST /games has no game ID")
+			}
+			state.ID = ""
+			state.Results = nil
+			if !reflect.DeepEqual(state, tt.wantState) {
+				t.Errorf("POST /games = %+v, want %+v", state, tt.wantState)
+			}
+		})
+	}
+}
+
+func TestServer_guess(t *testing.T) {
+	srv, ts := newTestServer(t)
+	id, secretWord := servertest.NewGame(t, srv.Store)
+	url := ts.URL + "/games/" + id + "/guesses"
+
+	tests := []struct {
+		name          string
+		url           string
+		body          string
+		wantStatus    int
+		wantWin       bool
+		wantRemaining int
+	}{
+		{
+			name:       "Invalid word",
+			url:        url,
+			body:       `{"word": "zzzzz"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Missing word",
+			url:        url,
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "Unknown game",
+			url:        ts.URL + "/games/unknown/guesses",
+			body:       `{"word": "stare"}`,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:          "Valid word",
+			url:           url,
+			body:          `{"word": "` + servertest.OtherWord(secretWord) + `"}`,
+			wantStatus:    http.StatusOK,
+			wantRemaining: 5,
+		},
+		{
+			name:          "Secret word",
+			url:           url,
+			body:          `{"word": "` + strings.ToUpper(secretWord) + `"}`,
+			wantStatus:    http.StatusOK,
+			wantWin:       true,
+			wantRemaining: 6,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var raw json.RawMessage
+			status := doJSON(t, http.MethodPost, tt.url, tt.body, &raw)
+			if status != tt.wantStatus {
+				t.Fatalf("POST %s = %d, want %d", tt.url, status, tt.wantStatus)
+			}
+			switch status {
+			case http.StatusOK:
+				var resp server.RoundResponse
+				if err := json.Unmarshal(raw, &resp); err != nil {
+					t.Fatal(err)
+				}
+				if resp.Result == nil {
+					t.Errorf("POST %s has no result", tt.url)
+				}
+				if resp.Win != tt.wantWin || resp.State.RemainingAttempts != tt.wantRemaining {
+					t.Errorf("POST %s win = %v, remaining attempts = %d, want %v and %d", tt.url, resp.Win, resp.State.RemainingAttempts, tt.wantWin, tt.wantRemaining)
+				}
+				if tt.wantWin && resp.SecretWord != secretWord {
+					t.Errorf("POST %s secret word = %q, want %q", tt.url, resp.SecretWord, secretWord)
+				}
+			case http.StatusUnprocessableEntity:
+				var resp server.ErrorResponse
+				if err := json.Unmarshal(raw, &resp); err != nil {
+					t.Fatal(err)
+				}
+				if len(resp.Messages) == 0 {
+					t.Errorf("POST %s has no messages", tt.url)
+				}
+			}
+		})
+	}
+}
+
+func TestServer_forfeitAndScore(t *testing.T) {
+	srv, ts := newTestServer(t)
+	id, secretWord := servertest.NewGame(t, srv.Store)
+
+	var round server.RoundResponse
+	if status := doJSON(t, http.MethodPost, ts.URL+"/games/"+id+"/forfeit", "", &round); status != http.StatusOK {
+		t.Fatalf("POST forfeit = %d, want %d", status, http.StatusOK)
+	}
+	if !round.Lose || round.SecretWord != secretWord || len(round.Messages) == 0 {
+		t.Errorf("POST forfeit = %+v, want a loss with the secret word %q and a message", round, secretWord)
+	}
+	if round.State.RemainingAttempts != 6 || len(round.State.Results) != 0 {
+		t.Errorf("POST forfeit state = %+v, want a new round", round.State)
+	}
+
+	var score server.ScoreResponse
+	if status := doJSON(t, http.MethodGet, ts.URL+"/games/"+id+"/score", "", &score); status != http.StatusOK {
+		t.Fatalf("GET score = %d, want %d", status, http.StatusOK)
+	}
+	if score != (server.ScoreResponse{Wins: 0, Losses: 1}) {
+		t.Errorf("GET score = %+v, want 0 wins and 1 loss", score)
+	}
+
+	var notFound server.ErrorResponse
+	for _, path := range []string{"/games/unknown/forfeit", "/games/unknown/score", "/games/unknown", "/other"} {
+		method := http.MethodGet
+		if strings.HasSuffix(path, "forfeit") {
+			method = http.MethodPost
+		}
+		if status := doJSON(t, method, ts.URL+path, "", &notFound); status != http.StatusNotFound {
+			t.Errorf("%s %s = %d, want %d", method, path, status, http.StatusNotFound)
+		}
+	}
+}
+
+func TestServer_secretWordNeverInState(t *testing.T) {
+	srv, ts := newTestServer(t)
+	id, secretWord := servertest.NewGame(t, srv.Store)
+
+	var guess server.RoundResponse
+	doJSON(t, http.MethodPost, ts.URL+"/games/"+id+"/guesses", `{"word": "`+servertest.OtherWord(secretWord)+`"}`, &guess)
+	resp, err := http.Get(ts.URL + "/games/" + id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := strings.ToLower(string(b))
+	if strings.Contains(body, secretWord) || strings.Contains(body, "secret") {
+		t.Errorf("GET /games/%s = %s, includes the secret word %q", id, body, secretWord)
+	}
+}
+
+func TestGameStore_keepsSharedWordList(t *testing.T) {
+	servertest.UseNoDictionary(t)
+	store := server.NewGameStore()
+	id, _ := servertest.NewGame(t, store)
+	filterWords := len(wengine.WordListCache.FilterWords)
+
+	for i := 0; i < 3; i++ {
+		if _, err := store.Forfeit(id); err != nil {
+			t.Fatalf("GameStore.Forfeit() error = %v", err)
+		}
+	}
+	if got := len(wengine.WordListCache.FilterWords); got != filterWords {
+		t.Errorf("len(FilterWords) = %d after forfeits, want %d", got, filterWords)
+	}
+	if _, err := store.State(id); err != nil {
+		t.Errorf("GameStore.State() error = %v", err)
+	}
+}
@@ -0,0 +1,41 @@
+// Package servertest has the fixtures shared by the tests of the REST API and the gRPC service.
+package servertest
+
+import (
+	"testing"
+
+	"github.com/tanmancan/gwordle/v1/internal/dictionaryapi"
+	"github.com/tanmancan/gwordle/v1/internal/server"
+	"github.com/tanmancan/gwordle/v1/internal/wengine"
+)
+
+// Use no dictionary until the test ends, so only words from the word list are accepted.
+func UseNoDictionary(t testing.TB) {
+	t.Helper()
+	prev := dictionaryapi.ActiveProvider
+	dictionaryapi.ActiveProvider = dictionaryapi.NoneProvider{}
+	t.Cleanup(func() { dictionaryapi.ActiveProvider = prev })
+}
+
+// Create a game in the store with 5 letter words and 6 tries, and get its ID and secret word.
+func NewGame(t testing.TB, store *server.GameStore) (string, string) {
+	t.Helper()
+	game, err := store.NewGame(server.GameRules{
+		WordLength: 5,
+		MaxTries: 6,
+	})
+	if err != nil {
+		t.Fatalf("GameStore.NewGame() error = %v", err)
+	}
+	return game.ID, game.Round.SecretWord
+}
+
+// Get a word of the word list other than the secret word.
+func OtherWord(secretWord string) string {
+	for _, word := range wengine.WordListCache.Words[wengine.WordLength(secretWord)] {
+		if word != secretWord {
+			return word
+		}
+	}
+	return ""
+}
@@ -0,0 +1,298 @@
+package server
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/tanmancan/gwordle/v1/internal/gengine"
+	"github.com/tanmancan/gwordle/v1/internal/localization"
+	"github.com/tanmancan/gwordle/v1/internal/wengine"
+)
+
+// Returned when no game exists for the given ID.
+var ErrGameNotFound = errors.New("game not found")
+
+// Returned when a guess word is rejected without consuming an attempt.
+type InvalidGuessError struct {
+	Messages []string // Localized messages explaining why the guess was rejected.
+}
+
+// Lists the messages explaining why the guess was rejected.
+func (e *InvalidGuessError) Error() string {
+	return fmt.Sprintf("invalid guess: %s", strings.Join(e.Messages, " "))
+}
+
+// Returned when a game cannot be played with the requested rules.
+type InvalidRulesError struct {
+	Err error // The error returned by gengine.NewGame.
+}
+
+// Describes why the rules cannot be played.
+func (e *InvalidRulesError) Error() string {
+	return fmt.Sprintf("invalid rules: %v", e.Err)
+}
+
+// Unwraps the error returned by gengine.NewGame.
+func (e *InvalidRulesError) Unwrap() error {
+	return e.Err
+}
+
+// Rules of a game, applied to every round.
+// Zero WordLength and MaxTries use the values from AppConfig.UserConfig.
+type GameRules struct {
+	HardMode bool
+	WordLength int
+	MaxTries int
+}
+
+// Snapshot of a game on the server.
+// Round contains the secret word, which must not be exposed while the round is in progress.
+type GameSnapshot struct {
+	ID string
+	HardMode bool
+	Round gengine.GameRound
+}
+
+// Outcome of a guess or a forfeit.
+// SecretWord is only set once the round has ended. A new round is started automatically.
+type RoundOutcome struct {
+	Result *wengine.ValidationResult
+	Win bool
+	Lose bool
+	SecretWord string
+	Messages []string
+	Game GameSnapshot
+}
+
+// Stores the save state of every game on the server, keyed by game ID.
+// Shared by every transport so a game can be accessed through any of them.
+type GameStore struct {
+	mu sync.Mutex // Guards the save states as well as the shared word list used by the engine.
+	saves map[string]*gengine.SaveState
+	rules map[string]GameRules
+}
+
+// Create an empty game store.
+func NewGameStore() *GameStore {
+	return &GameStore{
+		saves: make(map[string]*gengine.SaveState),
+		rules: make(map[string]GameRules),
+	}
+}
+
+// Server-side memory card for a single game in the GameStore.
+type ServerMemoryCard struct {
+	ID string
+	Store *GameStore
+}
+
+// Load the game from the store. Returns nil if the game does not exist.
+func (mc ServerMemoryCard) LoadGame() *gengine.SaveState {
+	s, ok := mc.Store.saves[mc.ID]
+	if !ok {
+		return nil
+	}
+	save := *s
+	return &save
+}
+
+// Save the game to the store.
+func (mc ServerMemoryCard) SaveGame(s *gengine.SaveState) {
+	save := *s
+	mc.Store.saves[mc.ID] = &save
+}
+
+// Get the messages describing how a round ended.
+func endRoundMessages(result gengine.Result) []string {
+	labelsEndRound := localization.AppTranslatable.EndRound
+	round := result.Round
+	switch {
+	case result.Win:
+		totalTries := round.GetTries()
+		return []string{fmt.Sprintf(labelsEndRound.WinMessage.Select(totalTries), round.SecretWord, totalTries)}
+	case result.Lose:
+		return []string{fmt.Sprintf(labelsEndRound.LoseMessage, strings.ToUpper(round.SecretWord))}
+	}
+	return nil
+}
+
+// Generate a random game ID.
+func newGameID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// Load a game from the store. Returns nil if the game does not exist, or an InvalidRulesError if it cannot be resumed.
+func (store *GameStore) loadGame(id string) (*gengine.Game, error) {
+	mc := ServerMemoryCard{
+		ID: id,
+		Store: store,
+	}
+	s := mc.LoadGame()
+	if s == nil {
+		return nil, nil
+	}
+	// The rules were validated when the game was created.
+	game, err := gengine.ResumeGame(store.options(store.rules[id], s))
+	if err != nil {
+		return nil, &InvalidRulesError{
+			Err: err,
+		}
+	}
+	return game, nil
+}
+
+// Get the engine options of a game with the given rules. Games on the server are always played on a single board.
+// Secret words are kept in the word list, since its filter is shared by every game on the server.
+func (store *GameStore) options(rules GameRules, s *gengine.SaveState) gengine.Options {
+	return gengine.Options{
+		HardMode: rules.HardMode,
+		WordLength: rules.WordLength,
+		MaxTries: rules.MaxTries,
+		Boards: 1,
+		KeepSecretWords: true,
+		SaveState: s,
+	}
+}
+
+// Save the game to the store and get its snapshot.
+func (store *GameStore) save(id string, game *gengine.Game) GameSnapshot {
+	gs := game.State()
+	ServerMemoryCard{
+		ID: id,
+		Store: store,
+	}.SaveGame(&gs.SaveState)
+	return GameSnapshot{
+		ID: id,
+		HardMode: gs.HardMode,
+		Round: gs.SaveState.CurrentGame,
+	}
+}
+
+// Create a game with the given rules and start its first round.
+// Returns an InvalidRulesError if the rules cannot be played.
+func (store *GameStore) NewGame(rules GameRules) (GameSnapshot, error) {
+	id, err := newGameID()
+	if err != nil {
+		return GameSnapshot{}, err
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	game, err := gengine.NewGame(store.options(rules, nil))
+	if err != nil {
+		return GameSnapshot{}, &InvalidRulesError{
+			Err: err,
+		}
+	}
+	store.rules[id] = rules
+
+	return store.save(id, game), nil
+}
+
+// Get the current state of a game.
+func (store *GameStore) State(id string) (GameSnapshot, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	game, err := store.loadGame(id)
+	if err != nil {
+		return GameSnapshot{}, err
+	}
+	if game == nil {
+		return GameSnapshot{}, ErrGameNotFound
+	}
+
+	gs := game.State()
+	return GameSnapshot{
+		ID: id,
+		HardMode: gs.HardMode,
+		Round: gs.SaveState.CurrentGame,
+	}, nil
+}
+
+// Submit a guess word for the current round of a game.
+// Returns an InvalidGuessError if the guess was rejected without consuming an attempt.
+func (store *GameStore) Guess(id string, word string) (RoundOutcome, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	game, err := store.loadGame(id)
+	if err != nil {
+		return RoundOutcome{}, err
+	}
+	if game == nil {
+		return RoundOutcome{}, ErrGameNotFound
+	}
+
+	result, err := game.Guess(word)
+	if err != nil {
+		return RoundOutcome{}, &InvalidGuessError{
+			Messages: []string{gengine.ErrorMessage(err)},
+		}
+	}
+
+	outcome := RoundOutcome{
+		Result: &result.Results[0],
+		Win: result.Win,
+		Lose: result.Lose,
+		Messages: endRoundMessages(result),
+	}
+	if result.Win || result.Lose {
+		outcome.SecretWord = result.Round.SecretWord
+	}
+	outcome.Game = store.save(id, game)
+	return outcome, nil
+}
+
+// Forfeit the current round of a game and start a new one.
+func (store *GameStore) Forfeit(id string) (RoundOutcome, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	game, err := store.loadGame(id)
+	if err != nil {
+		return RoundOutcome{}, err
+	}
+	if game == nil {
+		return RoundOutcome{}, ErrGameNotFound
+	}
+
+	result, err := game.Forfeit()
+	if err != nil {
+		return RoundOutcome{}, err
+	}
+
+	return RoundOutcome{
+		Lose: true,
+		SecretWord: result.Round.SecretWord,
+		Messages: endRoundMessages(result),
+		Game: store.save(id, game),
+	}, nil
+}
+
+// Get the total number of wins and losses of a game.
+func (store *GameStore) Score(id string) (win int, loss int, err error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	game, err := store.loadGame(id)
+	if err != nil {
+		return 0, 0, err
+	}
+	if game == nil {
+		return 0, 0, ErrGameNotFound
+	}
+
+	gs := game.State()
+	win, loss = gs.GetTotalWinLossCount()
+	return win, loss, nil
+}
@@ -0,0 +1,112 @@
+package wengine
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Hints revealed by previous guesses that every following guess must respect.
+type HintConstraints struct {
+	Positions map[int]string // Characters found in the correct position, keyed by their index.
+	Present map[string]int // Minimum number of times a revealed character must appear in the guess.
+	FoldAccents bool // Compare characters with their accents removed. Example: "á" satisfies a hint for "a".
+}
+
+// A hint that was ignored by a guess word.
+type ConstraintViolation struct {
+	Char string // The character from the violated hint.
+	Index int // Index of the character for ValidPosition hints. -1 for InvalidPosition hints.
+	Status CharValidationStatus // The status of the hint that was violated.
+}
+
+// Describes the violated hint.
+func (cv *ConstraintViolation) Error() string {
+	if cv.Status == ValidPosition {
+		return fmt.Sprintf("%s must be in position %d", cv.Char, cv.Index+1)
+	}
+	return fmt.Sprintf("guess must contain %s", cv.Char)
+}
+
+// Generate the hint constraints from a list of validation results.
+func GenerateHintConstraints(results []ValidationResult) (hc HintConstraints) {
+	hc.Positions = make(map[int]string)
+	hc.Present = make(map[string]int)
+
+	for _, result := range results {
+		counts := make(map[string]int)
+		for i, c := range result.Chars {
+			switch c.Status {
+			case ValidPosition:
+				hc.Positions[i] = c.Char
+				counts[c.Char]++
+			case InvalidPosition:
+				counts[c.Char]++
+			}
+		}
+
+		for char, count := range counts {
+			if count > hc.Present[char] {
+				hc.Present[char] = count
+			}
+		}
+	}
+
+	return hc
+}
+
+// Normalize a word or character before it is compared to a hint.
+func (hc *HintConstraints) compareKey(s string) string {
+	s = NormalizeWord(s)
+	if hc.FoldAccents {
+		s = FoldAccents(s)
+	}
+	return s
+}
+
+// Checks the guess word against the hint constraints.
+// Returns the first violated hint, or nil if the guess respects all hints.
+func (hc *HintConstraints) CheckWord(guess string) *ConstraintViolation {
+	guessChars := strings.Split(hc.compareKey(guess), "")
+
+	var indexes []int
+	for i := range hc.Positions {
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+
+	for _, i := range indexes {
+		char := hc.Positions[i]
+		if i >= len(guessChars) || guessChars[i] != hc.compareKey(char) {
+			return &ConstraintViolation{
+				Char: char,
+				Index: i,
+				Status: ValidPosition,
+			}
+		}
+	}
+
+	var chars []string
+	for char := range hc.Present {
+		chars = append(chars, char)
+	}
+	sort.Strings(chars)
+
+	for _, char := range chars {
+		count := 0
+		for _, c := range guessChars {
+			if c == hc.compareKey(char) {
+				count++
+			}
+		}
+		if count < hc.Present[char] {
+			return &ConstraintViolation{
+				Char: char,
+				Index: -1,
+				Status: InvalidPosition,
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package wengine
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGenerateHintConstraints(t *testing.T) {
+	first, _ := ValidateWord("lilts", "swill")
+	second, _ := ValidateWord("wills", "swill")
+	tests := []struct {
+		name    string
+		results []ValidationResult
+		want    HintConstraints
+	}{
+		{
+			name:    "No results",
+			results: nil,
+			want: HintConstraints{
+				Positions: map[int]string{},
+				Present:   map[string]int{},
+			},
+		},
+		{
+			name: "Guess word: lilts, wills. Secret word: swill",
+			results: []ValidationResult{
+				first,
+				second,
+			},
+			want: HintConstraints{
+				Positions: map[int]string{
+					3: "l",
+				},
+				Present: map[string]int{
+					"l": 2,
+					"i": 1,
+					"s": 1,
+					"w": 1,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GenerateHintConstraints(tt.results); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GenerateHintConstraints() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHintConstraints_CheckWord(t *testing.T) {
+	result, _ := ValidateWord("sxlxx", "swill")
+	hc := GenerateHintConstraints([]ValidationResult{result})
+	tests := []struct {
+		name  string
+		guess string
+		want  *ConstraintViolation
+	}{
+		{
+			name:  "Guess respects all hints",
+			guess: "swill",
+			want:  nil,
+		},
+		{
+			name:  "Guess moves a character found in the correct position",
+			guess: "lsxxx",
+			want: &ConstraintViolation{
+				Char:   "s",
+				Index:  0,
+				Status: ValidPosition,
+			},
+		},
+		{
+			name:  "Guess is missing a character found in the wrong position",
+			guess: "sxxxx",
+			want: &ConstraintViolation{
+				Char:   "l",
+				Index:  -1,
+				Status: InvalidPosition,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hc.CheckWord(tt.guess); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("HintConstraints.CheckWord() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package wengine
+
+// Status of a letter that has not been guessed in any of the results.
+const LetterUnused CharValidationStatus = "UNUSED"
+
+// Ranks the statuses of a letter. A higher rank is better known.
+var letterStatusRank = map[CharValidationStatus]int{
+	LetterUnused: 0,
+	InvalidCharacter: 1,
+	InvalidPosition: 2,
+	ValidPosition: 3,
+}
+
+// Best known status of each letter guessed in a set of validation results, keyed by the letter.
+type LetterStatuses map[string]CharValidationStatus
+
+// Get the best known status of each letter guessed in the results.
+// A letter found in the correct position is correct, even if another guess had it in the wrong position.
+// A repeated letter is absent only if every occurrence of it is absent. Example: guessing "speed" for "abide"
+// marks the second "e" absent, since the secret has a single "e", but "e" is still present.
+func GetLetterStatuses(results []ValidationResult) LetterStatuses {
+	statuses := make(LetterStatuses)
+	for _, result := range results {
+		for _, c := range result.Chars {
+			if letterStatusRank[c.Status] > letterStatusRank[statuses.Get(c.Char)] {
+				statuses[c.Char] = c.Status
+			}
+		}
+	}
+	return statuses
+}
+
+// Get the status of a letter. Letters that were not guessed are LetterUnused.
+func (ls LetterStatuses) Get(char string) CharValidationStatus {
+	if status, ok := ls[NormalizeWord(char)]; ok {
+		return status
+	}
+	return LetterUnused
+}
@@ -0,0 +1,128 @@
+package wengine
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetLetterStatuses(t *testing.T) {
+	tests := []struct {
+		name    string
+		guesses []string
+		secret  string
+		want    LetterStatuses
+	}{
+		{
+			name:    "No guesses",
+			guesses: nil,
+			secret:  "swill",
+			want:    LetterStatuses{},
+		},
+		{
+			name:    "Single guess",
+			guesses: []string{"spoil"},
+			secret:  "swill",
+			want: LetterStatuses{
+				"s": ValidPosition,
+				"p": InvalidCharacter,
+				"o": InvalidCharacter,
+				"i": InvalidPosition,
+				"l": ValidPosition,
+			},
+		},
+		{
+			name:    "Correct takes precedence over present from an earlier guess",
+			guesses: []string{"lxxxx", "xxxxl"},
+			secret:  "swill",
+			want: LetterStatuses{
+				"l": ValidPosition,
+				"x": InvalidCharacter,
+			},
+		},
+		{
+			name:    "Present is kept when a later guess has the letter absent",
+			guesses: []string{"lxxxx", "xlxxx"},
+			secret:  "abcdl",
+			want: LetterStatuses{
+				"l": InvalidPosition,
+				"x": InvalidCharacter,
+			},
+		},
+		{
+			name:    "Repeated letter with a single occurrence in the secret",
+			guesses: []string{"speed"},
+			secret:  "abide",
+			want: LetterStatuses{
+				"s": InvalidCharacter,
+				"p": InvalidCharacter,
+				"e": InvalidPosition,
+				"d": InvalidPosition,
+			},
+		},
+		{
+			name:    "Repeated letter correct in one position and absent in another",
+			guesses: []string{"eerie"},
+			secret:  "crane",
+			want: LetterStatuses{
+				"e": ValidPosition,
+				"r": InvalidPosition,
+				"i": InvalidCharacter,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var results []ValidationResult
+			for _, guess := range tt.guesses {
+				result, err := ValidateWord(guess, tt.secret)
+				if err != nil {
+					t.Fatal(err)
+				}
+				results = append(results, result)
+			}
+			if got := GetLetterStatuses(results); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetLetterStatuses() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLetterStatuses_Get(t *testing.T) {
+	statuses := LetterStatuses{
+		"a": ValidPosition,
+		"é": InvalidPosition,
+	}
+	tests := []struct {
+		name string
+		char string
+		want CharValidationStatus
+	}{
+		{
+			name: "Guessed letter",
+			char: "a",
+			want: ValidPosition,
+		},
+		{
+			name: "Upper case letter",
+			char: "A",
+			want: ValidPosition,
+		},
+		{
+			name: "Decomposed accented letter",
+			char: "é",
+			want: InvalidPosition,
+		},
+		{
+			name: "Letter that was not guessed",
+			char: "z",
+			want: LetterUnused,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := statuses.Get(tt.char); got != tt.want {
+				t.Errorf("LetterStatuses.Get() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
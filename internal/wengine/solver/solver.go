@@ -0,0 +1,126 @@
+// Package solver suggests guess words using the validation results of a round.
+package solver
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/tanmancan/gwordle/v1/internal/wengine"
+)
+
+// A guess word ranked by the expected information it reveals.
+type Suggestion struct {
+	Word string
+	Entropy float64 // Expected information in bits, over the feedback patterns of the remaining candidates.
+}
+
+// Encode the character statuses of a validation result into a comparable feedback pattern.
+func Pattern(result wengine.ValidationResult) string {
+	var sb strings.Builder
+	for _, c := range result.Chars {
+		switch c.Status {
+		case wengine.ValidPosition:
+			sb.WriteString("V")
+		case wengine.InvalidPosition:
+			sb.WriteString("P")
+		default:
+			sb.WriteString("X")
+		}
+	}
+	return sb.String()
+}
+
+// Returns the words that would have produced every one of the given validation results if they were the secret word.
+// The options must be the ones the results were validated with, or the patterns will not match.
+func FilterCandidates(words []string, results []wengine.ValidationResult, opts wengine.ValidationOptions) []string {
+	var candidates []string
+
+	for _, word := range words {
+		consistent := true
+		for _, result := range results {
+			got, err := wengine.ValidateWordWithOptions(result.GuessWord(), word, opts)
+			if err != nil || Pattern(got) != Pattern(result) {
+				consistent = false
+				break
+			}
+		}
+		if consistent {
+			candidates = append(candidates, word)
+		}
+	}
+
+	return candidates
+}
+
+// Get the expected information, in bits, revealed by the guess word over the remaining candidates.
+func Entropy(guess string, candidates []string, opts wengine.ValidationOptions) float64 {
+	if len(candidates) == 0 {
+		return 0
+	}
+
+	buckets := make(map[string]int)
+	for _, candidate := range candidates {
+		result, err := wengine.ValidateWordWithOptions(guess, candidate, opts)
+		if err != nil {
+			continue
+		}
+		buckets[Pattern(result)]++
+	}
+
+	total := float64(len(candidates))
+	entropy := 0.0
+	for _, count := range buckets {
+		p := float64(count) / total
+		entropy -= p * math.Log2(p)
+	}
+
+	return entropy
+}
+
+// Ranks the guess words by expected information over the remaining candidates and returns up to limit suggestions.
+// Ties are broken in favor of words that are still candidates, since they can win the round.
+func RankGuesses(guesses []string, candidates []string, limit int, opts wengine.ValidationOptions) []Suggestion {
+	isCandidate := make(map[string]bool)
+	for _, candidate := range candidates {
+		isCandidate[candidate] = true
+	}
+
+	suggestions := make([]Suggestion, 0, len(guesses))
+	for _, guess := range guesses {
+		suggestions = append(suggestions, Suggestion{
+			Word: guess,
+			Entropy: Entropy(guess, candidates, opts),
+		})
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		a, b := suggestions[i], suggestions[j]
+		if a.Entropy != b.Entropy {
+			return a.Entropy > b.Entropy
+		}
+		if isCandidate[a.Word] != isCandidate[b.Word] {
+			return isCandidate[a.Word]
+		}
+		return a.Word < b.Word
+	})
+
+	if limit > 0 && len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+
+	return suggestions
+}
+
+// Get the top suggestions for the next guess and the remaining candidates.
+// Every word of the given length in the word list is considered as a guess. The options are the ones of the round.
+func Suggest(wl *wengine.WordList, length int, results []wengine.ValidationResult, limit int, opts wengine.ValidationOptions) (suggestions []Suggestion, candidates []string) {
+	words := wl.Words[length]
+	candidates = FilterCandidates(words, results, opts)
+
+	if len(candidates) <= 2 {
+		return RankGuesses(candidates, candidates, limit, opts), candidates
+	}
+
+	return RankGuesses(words, candidates, limit, opts), candidates
+}
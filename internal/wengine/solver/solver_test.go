@@ -0,0 +1,181 @@
+package solver
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tanmancan/gwordle/v1/internal/wengine"
+)
+
+var testWords = []string{
+	"crate",
+	"sport",
+	"spore",
+	"short",
+	"snort",
+	"swill",
+}
+
+func mustValidate(guess string, secret string) wengine.ValidationResult {
+	result, err := wengine.ValidateWord(guess, secret)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+func mustValidateFolded(guess string, secret string) wengine.ValidationResult {
+	result, err := wengine.ValidateWordWithOptions(guess, secret, wengine.ValidationOptions{FoldAccents: true})
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+func TestFilterCandidates(t *testing.T) {
+	type args struct {
+		words   []string
+		results []wengine.ValidationResult
+		opts    wengine.ValidationOptions
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "No results keeps every word",
+			args: args{
+				words:   testWords,
+				results: nil,
+			},
+			want: testWords,
+		},
+		{
+			name: "Guess word: crate. Secret word: sport",
+			args: args{
+				words: testWords,
+				results: []wengine.ValidationResult{
+					mustValidate("crate", "sport"),
+				},
+			},
+			want: []string{
+				"sport",
+				"short",
+				"snort",
+			},
+		},
+		{
+			name: "Guess words: crate, spore. Secret word: sport",
+			args: args{
+				words: testWords,
+				results: []wengine.ValidationResult{
+					mustValidate("crate", "sport"),
+					mustValidate("spore", "sport"),
+				},
+			},
+			want: []string{
+				"sport",
+			},
+		},
+		{
+			name: "Guess word: árbol. Secret word: abril. Accents folded",
+			args: args{
+				words: []string{"abril", "árbol", "abrir"},
+				results: []wengine.ValidationResult{
+					mustValidateFolded("árbol", "abril"),
+				},
+				opts: wengine.ValidationOptions{FoldAccents: true},
+			},
+			want: []string{
+				"abril",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FilterCandidates(tt.args.words, tt.args.results, tt.args.opts); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FilterCandidates() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEntropy(t *testing.T) {
+	type args struct {
+		guess      string
+		candidates []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want float64
+	}{
+		{
+			name: "Single candidate reveals nothing",
+			args: args{
+				guess:      "sport",
+				candidates: []string{"sport"},
+			},
+			want: 0,
+		},
+		{
+			name: "Two candidates with different patterns reveal one bit",
+			args: args{
+				guess:      "sport",
+				candidates: []string{"sport", "swill"},
+			},
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Entropy(tt.args.guess, tt.args.candidates, wengine.ValidationOptions{}); got != tt.want {
+				t.Errorf("Entropy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRankGuesses(t *testing.T) {
+	type args struct {
+		guesses    []string
+		candidates []string
+		limit      int
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "Most informative guess is ranked first",
+			args: args{
+				guesses:    []string{"swill", "sport"},
+				candidates: []string{"sport", "short", "snort"},
+				limit:      1,
+			},
+			want: []string{"sport"},
+		},
+		{
+			name: "Ties prefer remaining candidates",
+			args: args{
+				guesses:    []string{"crate", "swill", "sport"},
+				candidates: []string{"sport"},
+				limit:      0,
+			},
+			want: []string{"sport", "crate", "swill"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, suggestion := range RankGuesses(tt.args.guesses, tt.args.candidates, tt.args.limit, wengine.ValidationOptions{}) {
+				got = append(got, suggestion.Word)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RankGuesses() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,6 +4,12 @@ import (
 	"errors"
 	"sort"
 	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
 )
 
 // Determines if a character is in a valid position, invalid position, or is not a valid guess.
@@ -27,21 +33,69 @@ type ValidationResult struct {
 	Chars []CharValidationResult
 }
 
+// Get the guess word that produced the validation result.
+func (vr ValidationResult) GuessWord() string {
+	var sb strings.Builder
+	for _, c := range vr.Chars {
+		sb.WriteString(c.Char)
+	}
+	return sb.String()
+}
+
+// Options that change how the guess and secret words are compared.
+type ValidationOptions struct {
+	FoldAccents bool // Treat accented characters as their base character. Example: "á" is treated as "a".
+}
+
+// Normalize a word so that it can be compared character by character.
+// The word is lower cased and composed (NFC), so "e" followed by a combining accent becomes "é".
+func NormalizeWord(word string) string {
+	return norm.NFC.String(strings.ToLower(word))
+}
+
+// Remove accents and other combining marks from a word. Example: "árbol" becomes "arbol".
+func FoldAccents(word string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	folded, _, err := transform.String(t, word)
+	if err != nil {
+		return word
+	}
+	return folded
+}
+
+// Get the number of characters in a word, rather than the number of bytes.
+func WordLength(word string) int {
+	return utf8.RuneCountInString(norm.NFC.String(word))
+}
 
 // Compares and validates a guess word against the secret word.
 func ValidateWord(guess string, secret string) (result ValidationResult, err error) {
-	guess = strings.ToLower(guess)
-	secret = strings.ToLower(secret)
+	return ValidateWordWithOptions(guess, secret, ValidationOptions{})
+}
+
+// Compares and validates a guess word against the secret word, using the given options.
+// Characters in the result are always taken from the guess word, even when accents are folded.
+func ValidateWordWithOptions(guess string, secret string, opts ValidationOptions) (result ValidationResult, err error) {
+	guess = NormalizeWord(guess)
+	secret = NormalizeWord(secret)
+	displayChars := strings.Split(guess, "")
+
+	if opts.FoldAccents {
+		guess = FoldAccents(guess)
+		secret = FoldAccents(secret)
+	}
+
 	result.Match = strings.Compare(guess, secret) == 0
+	guessChars := strings.Split(guess, "")
+	secretChars := strings.Split(secret, "")
 
-	if len(guess) != len(secret) {
+	if len(guessChars) != len(secretChars) || len(guessChars) != len(displayChars) {
 		err = errors.New("The guess and secret words are not the same length.")
 		return result, err
 	}
 
 	var guessWordMetadata WordMetadata
 	guessWordMetadata.GenerateWordMetadata(guess, secret)
-	guessChars := strings.Split(guess, "")
 
 	for i, c := range guessChars {
 		var compStatus CharValidationStatus
@@ -49,11 +103,11 @@ func ValidateWord(guess string, secret string) (result ValidationResult, err err
 		repeatingIndexInGuess := sort.SearchInts(cMetadata.IndexesInGuess, i)
 
 		switch {
-		case secret[i] == guess[i]:
+		case secretChars[i] == guessChars[i]:
 			compStatus = ValidPosition
 		case !cMetadata.InSecretWord():
 			compStatus = InvalidCharacter
-		case cMetadata.FoundAllSecretChar() && cMetadata.InSecretWord() && secret[i] != guess[i]:
+		case cMetadata.FoundAllSecretChar() && cMetadata.InSecretWord() && secretChars[i] != guessChars[i]:
 			compStatus = InvalidCharacter
 		case len(cMetadata.IndexesInSecret) > 0 &&
 		repeatingIndexInGuess < cMetadata.CountInSecret:
@@ -63,7 +117,7 @@ func ValidateWord(guess string, secret string) (result ValidationResult, err err
 		}
 
 		result.Chars = append(result.Chars, CharValidationResult{
-			Char: cMetadata.Char,
+			Char: displayChars[i],
 			Status: compStatus,
 		})
 	}
@@ -263,6 +263,116 @@ func TestValidateWord(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Spanish. Guess word: señal. Secret word: señor",
+			args: args{
+				guess:  "señal",
+				secret: "señor",
+			},
+			wantResult: ValidationResult{
+				Match: false,
+				Chars: []CharValidationResult{
+					{
+						Char:   "s",
+						Status: ValidPosition,
+					},
+					{
+						Char:   "e",
+						Status: ValidPosition,
+					},
+					{
+						Char:   "ñ",
+						Status: ValidPosition,
+					},
+					{
+						Char:   "a",
+						Status: InvalidCharacter,
+					},
+					{
+						Char:   "l",
+						Status: InvalidCharacter,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "German. Guess word: grüße. Secret word: größe",
+			args: args{
+				guess:  "grüße",
+				secret: "größe",
+			},
+			wantResult: ValidationResult{
+				Match: false,
+				Chars: []CharValidationResult{
+					{
+						Char:   "g",
+						Status: ValidPosition,
+					},
+					{
+						Char:   "r",
+						Status: ValidPosition,
+					},
+					{
+						Char:   "ü",
+						Status: InvalidCharacter,
+					},
+					{
+						Char:   "ß",
+						Status: ValidPosition,
+					},
+					{
+						Char:   "e",
+						Status: ValidPosition,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Portuguese. Guess word: mãos. Secret word: são (decomposed). Different lengths",
+			args: args{
+				guess:  "mãos",
+				secret: "sa\u0303o",
+			},
+			wantResult: ValidationResult{
+				Match: false,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Portuguese. Guess word: irmãs. Secret word: irmão (decomposed)",
+			args: args{
+				guess:  "irmãs",
+				secret: "irma\u0303o",
+			},
+			wantResult: ValidationResult{
+				Match: false,
+				Chars: []CharValidationResult{
+					{
+						Char:   "i",
+						Status: ValidPosition,
+					},
+					{
+						Char:   "r",
+						Status: ValidPosition,
+					},
+					{
+						Char:   "m",
+						Status: ValidPosition,
+					},
+					{
+						Char:   "ã",
+						Status: ValidPosition,
+					},
+					{
+						Char:   "s",
+						Status: InvalidCharacter,
+					},
+				},
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -277,3 +387,159 @@ func TestValidateWord(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateWordWithOptions(t *testing.T) {
+	type args struct {
+		guess  string
+		secret string
+		opts   ValidationOptions
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantResult ValidationResult
+		wantErr    bool
+	}{
+		{
+			name: "Accents are not folded. Guess word: arbol. Secret word: árbol",
+			args: args{
+				guess:  "arbol",
+				secret: "árbol",
+				opts:   ValidationOptions{},
+			},
+			wantResult: ValidationResult{
+				Match: false,
+				Chars: []CharValidationResult{
+					{
+						Char:   "a",
+						Status: InvalidCharacter,
+					},
+					{
+						Char:   "r",
+						Status: ValidPosition,
+					},
+					{
+						Char:   "b",
+						Status: ValidPosition,
+					},
+					{
+						Char:   "o",
+						Status: ValidPosition,
+					},
+					{
+						Char:   "l",
+						Status: ValidPosition,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Accents are folded. Guess word: ação. Secret word: acao",
+			args: args{
+				guess:  "ação",
+				secret: "acao",
+				opts: ValidationOptions{
+					FoldAccents: true,
+				},
+			},
+			wantResult: ValidationResult{
+				Match: true,
+				Chars: []CharValidationResult{
+					{
+						Char:   "a",
+						Status: ValidPosition,
+					},
+					{
+						Char:   "ç",
+						Status: ValidPosition,
+					},
+					{
+						Char:   "ã",
+						Status: ValidPosition,
+					},
+					{
+						Char:   "o",
+						Status: ValidPosition,
+					},
+				},
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotResult, err := ValidateWordWithOptions(tt.args.guess, tt.args.secret, tt.args.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateWordWithOptions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("ValidateWordWithOptions() = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestWordLength(t *testing.T) {
+	tests := []struct {
+		name string
+		word string
+		want int
+	}{
+		{
+			name: "ASCII word",
+			word: "hello",
+			want: 5,
+		},
+		{
+			name: "Spanish word with precomposed accent",
+			word: "árbol",
+			want: 5,
+		},
+		{
+			name: "Portuguese word with combining accent",
+			word: "irma\u0303o",
+			want: 5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WordLength(tt.word); got != tt.want {
+				t.Errorf("WordLength() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidationResult_GuessWord(t *testing.T) {
+	tests := []struct {
+		name   string
+		guess  string
+		secret string
+		opts   ValidationOptions
+	}{
+		{
+			name:   "ASCII word",
+			guess:  "stare",
+			secret: "poise",
+		},
+		{
+			name:   "Accented guess with folded accents",
+			guess:  "árbol",
+			secret: "abril",
+			opts:   ValidationOptions{FoldAccents: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ValidateWordWithOptions(tt.guess, tt.secret, tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := result.GuessWord(); got != tt.guess {
+				t.Errorf("ValidationResult.GuessWord() = %q, want %q", got, tt.guess)
+			}
+		})
+	}
+}
@@ -4,10 +4,12 @@ import (
 	"bufio"
 	"embed"
 	"fmt"
+	"path"
 	"sort"
 	"strings"
 
 	"github.com/tanmancan/gwordle/v1/internal/config"
+	"github.com/tanmancan/gwordle/v1/internal/dictionaryapi"
 	"golang.org/x/text/language"
 )
 
@@ -33,20 +35,50 @@ func (wfp *WordFileSystem) GetFileSystem() embed.FS {
 	return wfp.fs
 }
 
+// Get the locales with a valid word list in the file system, with English first so it is used when nothing matches.
+func (wfp *WordFileSystem) GetLocales() []language.Tag {
+	locales := []language.Tag{language.English}
+	entries, err := wfp.fs.ReadDir(path.Dir(path.Dir(wfp.validFilePathTemplate)))
+	if err != nil {
+		return locales
+	}
+	for _, entry := range entries {
+		tag, err := language.Parse(entry.Name())
+		if err != nil || tag == language.English {
+			continue
+		}
+		if _, err := wfp.fs.Open(fmt.Sprintf(wfp.validFilePathTemplate, tag.String())); err == nil {
+			locales = append(locales, tag)
+		}
+	}
+	return locales
+}
+
 //go:embed static
 var efs embed.FS
 
 func init() {
+	LoadLocale(config.GlobalConfig.Locale)
+}
+
+// Replace WordListCache with the word list for the supported locale closest to the given locale.
+// Returns the locale of the loaded word list. Example: es-MX loads the es word list, or en if there is none.
+// Words are looked up in the dictionary in the language of the loaded word list.
+func LoadLocale(tag language.Tag) language.Tag {
 	wordFs := WordFileSystem{
 		fs: efs,
 		validFilePathTemplate: "static/%s/valid",
 		invalidFilePathTemplate: "static/%s/invalid",
-		locale: config.GlobalConfig.Locale,
 	}
+	wordFs.locale = config.MatchLocale(tag, wordFs.GetLocales())
 	WordListCache = loadWordList(wordFs)
+	WordListCache.Locale = wordFs.locale
+	dictionaryapi.WordListLocale = wordFs.locale
+	return wordFs.locale
 }
 
-// Parses a scanner generated from a word list file and returns a list of words
+// Parses a scanner generated from a word list file and returns a list of words.
+// Words are grouped by their number of characters, not bytes.
 func scanWordListFile(scannerValidList *bufio.Scanner, scannerInvalidList *bufio.Scanner) (wordList WordList) {
 	var (
 		validWordList []string
@@ -57,14 +89,14 @@ func scanWordListFile(scannerValidList *bufio.Scanner, scannerInvalidList *bufio
 	scannerInvalidList.Split(bufio.ScanLines)
 
 	for scannerInvalidList.Scan() {
-		wiv := strings.Trim(scannerInvalidList.Text(), " ")
+		wiv := NormalizeWord(strings.Trim(scannerInvalidList.Text(), " "))
 		invalidWordList = append(invalidWordList, wiv)
 	}
 
 	sort.Strings(invalidWordList)
 
 	for scannerValidList.Scan() {
-		wv := strings.Trim(scannerValidList.Text(), " ")
+		wv := NormalizeWord(strings.Trim(scannerValidList.Text(), " "))
 		validWordList = append(validWordList, wv)
 	}
 
@@ -77,7 +109,7 @@ func scanWordListFile(scannerValidList *bufio.Scanner, scannerInvalidList *bufio
 				continue
 			}
 		}
-		length := len(word)
+		length := WordLength(word)
 		wordList.Words[length] = append(wordList.Words[length], word)
 	}
 
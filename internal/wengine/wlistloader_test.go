@@ -2,9 +2,11 @@ package wengine
 
 import (
 	"embed"
+	"path/filepath"
 	"reflect"
 	"testing"
 
+	"github.com/tanmancan/gwordle/v1/internal/dictionaryapi"
 	"golang.org/x/text/language"
 )
 
@@ -36,6 +38,7 @@ func Test_loadWordList(t *testing.T) {
 						"hi",
 					},
 					4: {
+						"café",
 						"test",
 					},
 					5: {
@@ -57,3 +60,77 @@ func Test_loadWordList(t *testing.T) {
 		})
 	}
 }
+
+func TestLoadLocale(t *testing.T) {
+	prev := WordListCache
+	prevLocale := dictionaryapi.WordListLocale
+	t.Cleanup(func() {
+		WordListCache = prev
+		dictionaryapi.WordListLocale = prevLocale
+	})
+	tests := []struct {
+		name     string
+		tag      language.Tag
+		want     language.Tag
+		wantWord string
+		wantLang string
+	}{
+		{
+			name:     "English",
+			tag:      language.English,
+			want:     language.English,
+			wantWord: "hello",
+			wantLang: "en",
+		},
+		{
+			name:     "Spanish",
+			tag:      language.Spanish,
+			want:     language.Spanish,
+			wantWord: "árbol",
+			wantLang: "es",
+		},
+		{
+			name:     "Regional Spanish falls back to Spanish",
+			tag:      language.MustParse("es-MX"),
+			want:     language.Spanish,
+			wantWord: "canción",
+			wantLang: "es",
+		},
+		{
+			name:     "Unsupported regional language falls back to English",
+			tag:      language.MustParse("pt-BR"),
+			want:     language.English,
+			wantWord: "hello",
+			wantLang: "en",
+		},
+		{
+			name:     "Unsupported language falls back to English",
+			tag:      language.German,
+			want:     language.English,
+			wantWord: "hello",
+			wantLang: "en",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LoadLocale(tt.tag); got != tt.want {
+				t.Errorf("LoadLocale() = %v, want %v", got, tt.want)
+			}
+			if WordListCache.Locale != tt.want {
+				t.Errorf("WordListCache.Locale = %v, want %v", WordListCache.Locale, tt.want)
+			}
+			if got := dictionaryapi.DictionaryLang(); got != tt.wantLang {
+				t.Errorf("dictionaryapi.DictionaryLang() = %q, want %q", got, tt.wantLang)
+			}
+			if path, err := dictionaryapi.DefaultCachePath(); err == nil && filepath.Base(path) != "dictionary-cache-"+tt.wantLang+".json" {
+				t.Errorf("dictionaryapi.DefaultCachePath() = %q, want the %s cache", path, tt.wantLang)
+			}
+			if !WordListCache.HasWord(tt.wantWord) {
+				t.Errorf("WordListCache.HasWord(%q) = false, want true", tt.wantWord)
+			}
+			if err := WordListCache.ValidateWordLength(5); err != nil {
+				t.Errorf("WordListCache.ValidateWordLength(5) = %v, want nil", err)
+			}
+		})
+	}
+}
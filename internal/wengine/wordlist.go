@@ -2,24 +2,52 @@ package wengine
 
 import (
 	"fmt"
+	"hash/fnv"
 	"log"
 	"math/rand"
 	"sort"
 	"time"
 
-	"github.com/tanmancan/gwordle/v1/internal/config"
 	"github.com/tanmancan/gwordle/v1/internal/dictionaryapi"
+	"golang.org/x/text/language"
 )
 
 // Create a list of words grouped by their length.
 type WordList struct {
-	Words map[int][]string // The key value is the length of the words in the value.
-	Definitions map[string]dictionaryapi.DictionaryApiDefinition // Stores the definition for a word using api.dictionaryapi.dev
+	Words map[int][]string // The key value is the number of characters in the words in the value.
+	Definitions map[string]dictionaryapi.DictionaryApiDefinition // Stores the definition for a word using dictionaryapi.ActiveProvider
 	FilterWords []string
+	Locale language.Tag // Locale of the words.
 }
 
 var WordListCache WordList
 
+// Minimum number of words of a given length needed to play with that length.
+const MinCandidateWords = 10
+
+// Returned when the word list does not have enough words of the requested length.
+type NotEnoughWordsError struct {
+	Length int // The requested word length.
+	Count int // The number of words found with that length.
+}
+
+// Describes the requested length and how many words were found.
+func (e *NotEnoughWordsError) Error() string {
+	return fmt.Sprintf("Not enough words of length %d: found %d, need at least %d.", e.Length, e.Count, MinCandidateWords)
+}
+
+// Checks that there are enough words of the given length, after WordList.FilterWords is applied, to play with that length.
+func (wl *WordList) ValidateWordLength(length int) error {
+	count := len(wl.FilterWordList(wl.Words[length]))
+	if count < MinCandidateWords {
+		return &NotEnoughWordsError{
+			Length: length,
+			Count: count,
+		}
+	}
+	return nil
+}
+
 // Get a random word from the wordlist that matches the request word length.
 // Will filter out any words found within WordList.FilterWords
 func (wl *WordList) GetRandomWord(length int) string {
@@ -42,9 +70,10 @@ func (wl *WordList) GetRandomWord(length int) string {
 	}
 	word := words[randomIdx]
 
-	valid := wl.CheckDictionary(word)
-	if (!valid) {
-		invalidPath := fmt.Sprintf("internal/wengine/static/%s/invalid", config.GlobalConfig.Locale.String())
+	// Only definite misses are recorded. An unavailable dictionary says nothing about the word.
+	definition, unavailable := wl.lookupDefinition(word)
+	if (definition == nil && !unavailable) {
+		invalidPath := fmt.Sprintf("internal/wengine/static/%s/invalid", wl.Locale.String())
 		WordListFileWriter(invalidPath, word)
 	} else {
 		// wl.ShowDefinition(word)
@@ -53,9 +82,33 @@ func (wl *WordList) GetRandomWord(length int) string {
 	return word
 }
 
+// Get the daily word for the given date, locale and word length.
+// The same inputs always return the same word, so every player gets the same puzzle on the same day.
+// WordList.FilterWords is not applied since it differs between players.
+func (wl *WordList) GetDailyWord(length int, date string, locale language.Tag) string {
+	if len(wl.Words) == 0 {
+		log.Fatalln("No words were loaded")
+	}
+
+	if len(wl.Words[length]) == 0 {
+		log.Fatalln("No word found for given length:", length)
+	}
+
+	words := make([]string, len(wl.Words[length]))
+	copy(words, wl.Words[length])
+	sort.Strings(words)
+
+	h := fnv.New64a()
+	h.Write([]byte(fmt.Sprintf("%s:%s:%d", date, locale.String(), length)))
+	dailyIdx := h.Sum64() % uint64(len(words))
+
+	return words[dailyIdx]
+}
+
 // Checks if the given word exists in the word list.
 func (wl *WordList) HasWord(word string) bool {
-	length := len(word)
+	word = NormalizeWord(word)
+	length := WordLength(word)
 	words := wl.Words[length]
 	sort.Strings(words)
 	searchIdx := sort.SearchStrings(words, word)
@@ -63,6 +116,19 @@ func (wl *WordList) HasWord(word string) bool {
 	return searchIdx < len(words) && words[searchIdx] == word
 }
 
+// Checks if the given word exists in the word list when accents are ignored.
+// Example: "arbol" will match "árbol".
+func (wl *WordList) HasFoldedWord(word string) bool {
+	folded := FoldAccents(NormalizeWord(word))
+	for _, w := range wl.Words[WordLength(folded)] {
+		if FoldAccents(w) == folded {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Add a word to the filter list
 func (wl *WordList) SetFilterWord(word string) {
 	existingIdx := sort.SearchStrings(wl.FilterWords, word)
@@ -106,28 +172,33 @@ func (wl *WordList) FilterWordList(words []string) []string {
 
 // Returns a cached definition for the given word. If no cache found, fetches and caches the definition first.
 func (wl *WordList) GetDefinition(word string) (*dictionaryapi.DictionaryApiDefinition) {
+	definition, _ := wl.lookupDefinition(word)
+	return definition
+}
+
+// Same as GetDefinition, but also reports if the dictionary could not answer, or no dictionary is configured.
+func (wl *WordList) lookupDefinition(word string) (definition *dictionaryapi.DictionaryApiDefinition, unavailable bool) {
 	if def, cached := wl.Definitions[word]; cached {
-		return &def
+		return &def, false
 	}
 
-	request := dictionaryapi.GetWordDefinitionRequest{
-		Word: word,
-	}
-	apiResponse := dictionaryapi.GetWordDefinition(request)
+	def, found, err := dictionaryapi.Lookup(word)
 
-	if (apiResponse.Error != dictionaryapi.DictionaryApiResponseError{}) {
-		return nil
+	if err != nil {
+		return nil, true
+	}
+	if !found {
+		return nil, false
 	}
 
 	if wl.Definitions == nil {
 		wl.Definitions = make(map[string]dictionaryapi.DictionaryApiDefinition)
 	}
-	def := apiResponse.Response[0]
-	wl.Definitions[word] = def
-	return &def
+	wl.Definitions[word] = *def
+	return def, false
 }
 
-// Uses dictionaryapi.dev to see if the provided word is valid.
+// Uses dictionaryapi.ActiveProvider to see if the provided word is valid.
 func (wl *WordList) CheckDictionary(word string) bool {
 	definition := wl.GetDefinition(word)
 
@@ -144,6 +215,7 @@ func (wl *WordList) ShowDefinition(word string) {
 
 	if (definition == nil) {
 		fmt.Println("No definition found for the word:", word)
+		return
 	}
 	fmt.Printf(
 		"%s (%s): %s\n",
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/tanmancan/gwordle/v1/internal/dictionaryapi"
+	"golang.org/x/text/language"
 )
 
 type fields struct {
@@ -373,3 +374,169 @@ func TestWordList_FilterWordList(t *testing.T) {
 		})
 	}
 }
+
+func TestWordList_GetDailyWord(t *testing.T) {
+	type args struct {
+		length int
+		date   string
+		locale language.Tag
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   []string
+	}{
+		{
+			name: "Return daily word with len 3",
+			fields: fields{
+				Words: wordList,
+			},
+			args: args{
+				length: 3,
+				date:   "2022-04-12",
+				locale: language.English,
+			},
+			want: lenThree,
+		},
+		{
+			name: "Filter words are not applied to the daily word",
+			fields: fields{
+				Words:       wordList,
+				FilterWords: lenFour,
+			},
+			args: args{
+				length: 4,
+				date:   "2022-04-13",
+				locale: language.English,
+			},
+			want: lenFour,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wl := &WordList{
+				Words:       tt.fields.Words,
+				FilterWords: tt.fields.FilterWords,
+			}
+			got := wl.GetDailyWord(tt.args.length, tt.args.date, tt.args.locale)
+			want := make([]string, len(tt.want))
+			copy(want, tt.want)
+			sort.Strings(want)
+			searchIdx := sort.SearchStrings(want, got)
+			if searchIdx == len(want) || want[searchIdx] != got {
+				t.Errorf("WordList.GetDailyWord() = %v, want one of %v", got, want)
+			}
+			if again := wl.GetDailyWord(tt.args.length, tt.args.date, tt.args.locale); again != got {
+				t.Errorf("WordList.GetDailyWord() = %v, want the same word %v on every call", again, got)
+			}
+		})
+	}
+}
+
+func TestWordList_HasFoldedWord(t *testing.T) {
+	tests := []struct {
+		name  string
+		words map[int][]string
+		word  string
+		want  bool
+	}{
+		{
+			name: "Matches word without accents",
+			words: map[int][]string{
+				5: {"árbol", "señor"},
+			},
+			word: "arbol",
+			want: true,
+		},
+		{
+			name: "Matches word with different accents",
+			words: map[int][]string{
+				5: {"árbol", "señor"},
+			},
+			word: "senor",
+			want: true,
+		},
+		{
+			name: "Does not match a different word",
+			words: map[int][]string{
+				5: {"árbol", "señor"},
+			},
+			word: "arbor",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wl := &WordList{
+				Words: tt.words,
+			}
+			if got := wl.HasFoldedWord(tt.word); got != tt.want {
+				t.Errorf("WordList.HasFoldedWord() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWordList_ValidateWordLength(t *testing.T) {
+	tests := []struct {
+		name    string
+		fields  fields
+		length  int
+		wantErr error
+	}{
+		{
+			name: "Enough words",
+			fields: fields{
+				Words: wordList,
+			},
+			length:  3,
+			wantErr: nil,
+		},
+		{
+			name: "Not enough words",
+			fields: fields{
+				Words: wordList,
+			},
+			length: 5,
+			wantErr: &NotEnoughWordsError{
+				Length: 5,
+				Count:  2,
+			},
+		},
+		{
+			name: "Not enough words after filter words are removed",
+			fields: fields{
+				Words:       wordList,
+				FilterWords: []string{"this", "word"},
+			},
+			length: 4,
+			wantErr: &NotEnoughWordsError{
+				Length: 4,
+				Count:  8,
+			},
+		},
+		{
+			name: "No words",
+			fields: fields{
+				Words: wordList,
+			},
+			length: 18,
+			wantErr: &NotEnoughWordsError{
+				Length: 18,
+				Count:  0,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wl := &WordList{
+				Words:       tt.fields.Words,
+				FilterWords: tt.fields.FilterWords,
+			}
+			if err := wl.ValidateWordLength(tt.length); !reflect.DeepEqual(err, tt.wantErr) {
+				t.Errorf("WordList.ValidateWordLength() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -66,7 +66,8 @@ type WordMetadata struct {
 	Chars map[string]CharMetadata
 }
 
-// Generate useful metadata for each character in the guess word compared to the secret word
+// Generate useful metadata for each character in the guess word compared to the secret word.
+// Indexes are character indexes, not byte offsets.
 func (gwm *WordMetadata) GenerateWordMetadata(guess string, secret string) {
 	gwm.Chars = make(map[string]CharMetadata)
 	guessChars := strings.Split(guess, "")